@@ -1,9 +1,6 @@
 package graph
 
-import (
-	"container/list"
-	"fmt"
-)
+import "container/list"
 
 type internalEdge[T comparable] struct {
 	u T
@@ -30,23 +27,41 @@ func (g *adjacencyListGraph[T, D]) Vertices() []T {
 
 func (g *adjacencyListGraph[T, D]) AddEdge(u T, v T, d D) (*Edge[T, D], error) {
 	if !g.ContainsVertex(u) {
-		return nil, fmt.Errorf("unknown vertex %v:%T", u, u)
+		return nil, vertexNotFound(u)
 	}
 
 	if !g.ContainsVertex(v) {
-		return nil, fmt.Errorf("unknown vertex %v:%T", v, v)
+		return nil, vertexNotFound(v)
 	}
 
-	edge := newInternalEdge(u, v)
-	if _, ok := g.edges[edge]; !ok {
+	if u == v {
+		return nil, &EdgeError[T]{U: u, V: v, Err: ErrSelfLoop}
+	}
+
+	edge, ok := g.edgeKey(u, v)
+	if !ok {
 		g.edges[edge] = d
 		g.vertices[u].PushBack(edge)
 		g.vertices[v].PushBack(edge)
-	} 
-	r := NewEdge(u, v, g.edges[edge])
+	}
+	r := NewEdge(edge.u, edge.v, g.edges[edge])
 	return &r, nil
 }
 
+// edgeKey finds the stored key for the undirected edge between u and v in
+// either orientation, if there is none the (u, v) orientation is returned
+func (g *adjacencyListGraph[T, D]) edgeKey(u T, v T) (internalEdge[T], bool) {
+	edge := newInternalEdge(u, v)
+	if _, ok := g.edges[edge]; ok {
+		return edge, true
+	}
+	reversed := newInternalEdge(v, u)
+	if _, ok := g.edges[reversed]; ok {
+		return reversed, true
+	}
+	return edge, false
+}
+
 func (g *adjacencyListGraph[T, D]) Edges() []Edge[T, D] {
 	edges := make([]Edge[T, D], len(g.edges))
 
@@ -70,29 +85,50 @@ func (g *adjacencyListGraph[T, D]) VectorEdges(v T) []Edge[T, D] {
 
 func (g *adjacencyListGraph[T, D]) AddVertex(v T) error {
 	if g.ContainsVertex(v) {
-		return fmt.Errorf("graph already contains vertex %v", v)
+		return &VertexError[T]{Vertex: v, Err: ErrDuplicateVertex}
 	}
 	g.vertices[v] = list.New()
 	return nil
 }
 
-func (g *adjacencyListGraph[T, D]) RemoveVertex(v T) {
+// RemoveVertex removes v and every edge incident to it, returning the removed
+// edges
+func (g *adjacencyListGraph[T, D]) RemoveVertex(v T) ([]Edge[T, D], error) {
+	if !g.ContainsVertex(v) {
+		return nil, vertexNotFound(v)
+	}
+	removed := g.VectorEdges(v)
+	for _, e := range removed {
+		g.RemoveEdge(e)
+	}
 	delete(g.vertices, v)
+	return removed, nil
 }
 
-func (g *adjacencyListGraph[T, D]) RemoveEdge(e Edge[T, D]) {
-	iEdge := newInternalEdge(e.u, e.v)
-	element := elementFromList[internalEdge[T]](*g.vertices[e.u], iEdge)
+func (g *adjacencyListGraph[T, D]) RemoveEdge(e Edge[T, D]) error {
+	if !g.ContainsVertex(e.u) {
+		return vertexNotFound(e.u)
+	}
+	if !g.ContainsVertex(e.v) {
+		return vertexNotFound(e.v)
+	}
+	iEdge, ok := g.edgeKey(e.u, e.v)
+	if !ok {
+		return &EdgeError[T]{U: e.u, V: e.v, Err: ErrEdgeNotFound}
+	}
+
+	element := elementFromList[internalEdge[T]](*g.vertices[iEdge.u], iEdge)
 	if element != nil {
-		g.vertices[e.u].Remove(element)
+		g.vertices[iEdge.u].Remove(element)
 	}
-	
-	element = elementFromList[internalEdge[T]](*g.vertices[e.v], iEdge)
+
+	element = elementFromList[internalEdge[T]](*g.vertices[iEdge.v], iEdge)
 	if element != nil {
-		g.vertices[e.v].Remove(element)
+		g.vertices[iEdge.v].Remove(element)
 	}
 
-	delete(g.edges, newInternalEdge(e.u, e.v))
+	delete(g.edges, iEdge)
+	return nil
 }
 
 func (g *adjacencyListGraph[T, D]) ContainsVertex(v T) bool {
@@ -101,7 +137,6 @@ func (g *adjacencyListGraph[T, D]) ContainsVertex(v T) bool {
 }
 
 func (g *adjacencyListGraph[T, D]) ContainsEdge(e Edge[T, D]) bool {
-	ie := newInternalEdge(e.u, e.v) 
-	_, ok := g.edges[ie]
+	_, ok := g.edgeKey(e.u, e.v)
 	return ok
 }
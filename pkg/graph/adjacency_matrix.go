@@ -1 +1,147 @@
-package graph
\ No newline at end of file
+package graph
+
+// Undirected graph based on adjacency matrix implementation. Vertices are
+// mapped to a dense index into the matrix, each undirected edge is stored in
+// both of its cells.
+type adjacencyMatrixGraph[T comparable, D comparable] struct {
+	index    map[T]int
+	vertices []T
+	matrix   [][]*Edge[T, D]
+}
+
+func NewAdjacencyMatrixGraph[T comparable, D comparable]() *adjacencyMatrixGraph[T, D] {
+	return &adjacencyMatrixGraph[T, D]{index: make(map[T]int)}
+}
+
+func (g *adjacencyMatrixGraph[T, D]) Vertices() []T {
+	r := make([]T, len(g.vertices))
+	copy(r, g.vertices)
+	return r
+}
+
+func (g *adjacencyMatrixGraph[T, D]) AddEdge(u T, v T, d D) (*Edge[T, D], error) {
+	iu, ok := g.index[u]
+	if !ok {
+		return nil, vertexNotFound(u)
+	}
+
+	iv, ok := g.index[v]
+	if !ok {
+		return nil, vertexNotFound(v)
+	}
+
+	if iu == iv {
+		return nil, &EdgeError[T]{U: u, V: v, Err: ErrSelfLoop}
+	}
+
+	if g.matrix[iu][iv] == nil {
+		e := NewEdge(u, v, d)
+		g.matrix[iu][iv] = &e
+		g.matrix[iv][iu] = &e
+	}
+	r := *g.matrix[iu][iv]
+	return &r, nil
+}
+
+func (g *adjacencyMatrixGraph[T, D]) Edges() []Edge[T, D] {
+	edges := make([]Edge[T, D], 0)
+	for i, row := range g.matrix {
+		for j := i; j < len(row); j++ {
+			if row[j] != nil {
+				edges = append(edges, *row[j])
+			}
+		}
+	}
+	return edges
+}
+
+func (g *adjacencyMatrixGraph[T, D]) VectorEdges(v T) []Edge[T, D] {
+	i, ok := g.index[v]
+	if !ok {
+		return []Edge[T, D]{}
+	}
+	edges := make([]Edge[T, D], 0)
+	for _, e := range g.matrix[i] {
+		if e != nil {
+			edges = append(edges, *e)
+		}
+	}
+	return edges
+}
+
+// AddVertex grows the matrix by one row and column
+func (g *adjacencyMatrixGraph[T, D]) AddVertex(v T) error {
+	if g.ContainsVertex(v) {
+		return &VertexError[T]{Vertex: v, Err: ErrDuplicateVertex}
+	}
+	n := len(g.vertices)
+	g.index[v] = n
+	g.vertices = append(g.vertices, v)
+	for i := range g.matrix {
+		g.matrix[i] = append(g.matrix[i], nil)
+	}
+	g.matrix = append(g.matrix, make([]*Edge[T, D], n+1))
+	return nil
+}
+
+// RemoveVertex moves the last vertex into the slot of the removed one so the
+// matrix stays dense, this drops every edge incident to v and returns them.
+func (g *adjacencyMatrixGraph[T, D]) RemoveVertex(v T) ([]Edge[T, D], error) {
+	i, ok := g.index[v]
+	if !ok {
+		return nil, vertexNotFound(v)
+	}
+	removed := g.VectorEdges(v)
+	last := len(g.vertices) - 1
+
+	for r := range g.matrix {
+		g.matrix[r][i] = g.matrix[r][last]
+	}
+	g.matrix[i] = g.matrix[last]
+	g.matrix = g.matrix[:last]
+	for r := range g.matrix {
+		g.matrix[r][last] = nil
+		g.matrix[r] = g.matrix[r][:last]
+	}
+
+	moved := g.vertices[last]
+	g.vertices[i] = moved
+	g.vertices = g.vertices[:last]
+	g.index[moved] = i
+	delete(g.index, v)
+	return removed, nil
+}
+
+func (g *adjacencyMatrixGraph[T, D]) RemoveEdge(e Edge[T, D]) error {
+	iu, ok := g.index[e.u]
+	if !ok {
+		return vertexNotFound(e.u)
+	}
+	iv, ok := g.index[e.v]
+	if !ok {
+		return vertexNotFound(e.v)
+	}
+	if g.matrix[iu][iv] == nil {
+		return &EdgeError[T]{U: e.u, V: e.v, Err: ErrEdgeNotFound}
+	}
+	g.matrix[iu][iv] = nil
+	g.matrix[iv][iu] = nil
+	return nil
+}
+
+func (g *adjacencyMatrixGraph[T, D]) ContainsVertex(v T) bool {
+	_, ok := g.index[v]
+	return ok
+}
+
+func (g *adjacencyMatrixGraph[T, D]) ContainsEdge(e Edge[T, D]) bool {
+	iu, ok := g.index[e.u]
+	if !ok {
+		return false
+	}
+	iv, ok := g.index[e.v]
+	if !ok {
+		return false
+	}
+	return g.matrix[iu][iv] != nil
+}
@@ -0,0 +1,212 @@
+package graph
+
+import "slices"
+
+// DistanceMatrix holds the shortest path between every ordered pair of
+// vertices, as computed by FloydWarshall or Johnson
+type DistanceMatrix[T comparable, D comparable, W Weight] struct {
+	vertices  []T
+	index     map[T]int
+	dist      [][]W
+	reachable [][]bool
+	// pred[i][j] is the last edge on the path from i to j
+	pred [][]*Edge[T, D]
+}
+
+func newDistanceMatrix[T comparable, D comparable, W Weight](vertices []T) *DistanceMatrix[T, D, W] {
+	n := len(vertices)
+	m := &DistanceMatrix[T, D, W]{
+		vertices:  vertices,
+		index:     make(map[T]int, n),
+		dist:      make([][]W, n),
+		reachable: make([][]bool, n),
+		pred:      make([][]*Edge[T, D], n),
+	}
+	for i, v := range vertices {
+		m.index[v] = i
+		m.dist[i] = make([]W, n)
+		m.reachable[i] = make([]bool, n)
+		m.reachable[i][i] = true
+		m.pred[i] = make([]*Edge[T, D], n)
+	}
+	return m
+}
+
+func (m *DistanceMatrix[T, D, W]) Vertices() []T {
+	r := make([]T, len(m.vertices))
+	copy(r, m.vertices)
+	return r
+}
+
+// Distance returns the length of the shortest path from u to v, false if
+// there is none
+func (m *DistanceMatrix[T, D, W]) Distance(u T, v T) (W, bool) {
+	iu, ok := m.index[u]
+	if !ok {
+		return 0, false
+	}
+	iv, ok := m.index[v]
+	if !ok || !m.reachable[iu][iv] {
+		return 0, false
+	}
+	return m.dist[iu][iv], true
+}
+
+func (m *DistanceMatrix[T, D, W]) Reachable(u T, v T) bool {
+	_, ok := m.Distance(u, v)
+	return ok
+}
+
+// Path reconstructs the shortest path from u to v
+func (m *DistanceMatrix[T, D, W]) Path(u T, v T) (*Path[T, D, W], error) {
+	iu, ok := m.index[u]
+	if !ok {
+		return nil, vertexNotFound(u)
+	}
+	iv, ok := m.index[v]
+	if !ok {
+		return nil, vertexNotFound(v)
+	}
+	if !m.reachable[iu][iv] {
+		return nil, &EdgeError[T]{U: u, V: v, Err: ErrNoPath}
+	}
+
+	vertices := []T{v}
+	edges := make([]Edge[T, D], 0)
+	for j := iv; j != iu && len(edges) < len(m.vertices); {
+		e := *m.pred[iu][j]
+		prev := e.Other(m.vertices[j])
+		vertices = append(vertices, prev)
+		edges = append(edges, e)
+		j = m.index[prev]
+	}
+	slices.Reverse(vertices)
+	slices.Reverse(edges)
+	return &Path[T, D, W]{Vertices: vertices, Edges: edges, Weight: m.dist[iu][iv]}, nil
+}
+
+// UnreachablePairs lists every ordered pair of distinct vertices with no path
+// between them
+func (m *DistanceMatrix[T, D, W]) UnreachablePairs() [][2]T {
+	r := make([][2]T, 0)
+	for i := range m.vertices {
+		for j := range m.vertices {
+			if !m.reachable[i][j] {
+				r = append(r, [2]T{m.vertices[i], m.vertices[j]})
+			}
+		}
+	}
+	return r
+}
+
+// FloydWarshall computes all pairs shortest paths in O(V^3), best suited to
+// dense graphs. Negative weights are allowed, a negative cycle is reported as
+// a NegativeCycleError.
+func FloydWarshall[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (*DistanceMatrix[T, D, W], error) {
+	var m *DistanceMatrix[T, D, W]
+	relax := func(i int, j int, e *Edge[T, D]) {
+		w := weight(*e)
+		if !m.reachable[i][j] || w < m.dist[i][j] {
+			m.dist[i][j] = w
+			m.reachable[i][j] = true
+			m.pred[i][j] = e
+		}
+	}
+
+	if am, ok := g.(*adjacencyMatrixGraph[T, D]); ok {
+		// read the adjacency matrix directly rather than going through
+		// VectorEdges and the vertex index for every edge
+		m = newDistanceMatrix[T, D, W](am.Vertices())
+		for i, row := range am.matrix {
+			for j, e := range row {
+				if e != nil {
+					relax(i, j, e)
+				}
+			}
+		}
+	} else {
+		m = newDistanceMatrix[T, D, W](g.Vertices())
+		for _, a := range arcs(g) {
+			e := a.e
+			relax(m.index[a.from], m.index[a.to], &e)
+		}
+	}
+
+	n := len(m.vertices)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if !m.reachable[i][k] {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if !m.reachable[k][j] {
+					continue
+				}
+				d := m.dist[i][k] + m.dist[k][j]
+				if !m.reachable[i][j] || d < m.dist[i][j] {
+					m.dist[i][j] = d
+					m.reachable[i][j] = true
+					m.pred[i][j] = m.pred[k][j]
+				}
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if m.dist[i][i] < 0 {
+			// Bellman-Ford from a vertex on the cycle recovers it
+			if _, err := BellmanFord(g, m.vertices[i], weight); err != nil {
+				return nil, err
+			}
+			return nil, ErrNegativeCycle
+		}
+	}
+	return m, nil
+}
+
+// Johnson computes all pairs shortest paths with one Bellman-Ford pass to
+// reweight the edges followed by Dijkstra from every vertex, which is faster
+// than FloydWarshall on sparse graphs. Negative weights are allowed, a
+// negative cycle is reported as a NegativeCycleError.
+func Johnson[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (*DistanceMatrix[T, D, W], error) {
+	// starting every vertex at zero is the same as adding a new source with a
+	// zero weight edge to each vertex
+	vertices := g.Vertices()
+	potential := &ShortestPaths[T, D, W]{
+		Dist: make(map[T]W, len(vertices)),
+		Pred: make(map[T]Edge[T, D]),
+	}
+	for _, v := range vertices {
+		potential.Dist[v] = 0
+	}
+	if err := bellmanFord(g, potential, edgeWeight(weight)); err != nil {
+		return nil, err
+	}
+	h := potential.Dist
+
+	reweighted := func(from T, e Edge[T, D]) W {
+		w := weight(e) + h[from] - h[e.Other(from)]
+		// never negative in exact arithmetic, clamp float rounding error
+		if w < 0 {
+			w = 0
+		}
+		return w
+	}
+
+	m := newDistanceMatrix[T, D, W](vertices)
+	for i, s := range vertices {
+		sp, err := dijkstra(g, s, nil, reweighted)
+		if err != nil {
+			return nil, err
+		}
+		for v, d := range sp.Dist {
+			j := m.index[v]
+			m.dist[i][j] = d - h[s] + h[v]
+			m.reachable[i][j] = true
+			if e, ok := sp.Pred[v]; ok {
+				m.pred[i][j] = &e
+			}
+		}
+	}
+	return m, nil
+}
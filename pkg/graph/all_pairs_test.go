@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type allPairsFunc func(Graph[string, int], func(Edge[string, int]) int) (*DistanceMatrix[string, int, int], error)
+
+func TestAllPairs(t *testing.T) {
+	for _, allPairs := range []allPairsFunc{FloydWarshall[string, int, int], Johnson[string, int, int]} {
+		allPairsTest(weightedTestGraph(NewAdjacencyListGraph[string, int]()), allPairs, t)
+		allPairsTest(weightedTestGraph(NewAdjacencyMatrixGraph[string, int]()), allPairs, t)
+		allPairsTest(weightedTestGraph(NewDirectedAdjacencyListGraph[string, int]()), allPairs, t)
+	}
+}
+
+// checks every distance and path against Dijkstra from each vertex
+func allPairsTest(g Graph[string, int], allPairs allPairsFunc, t *testing.T) {
+	m, err := allPairs(g, intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	unreachable := 0
+	for _, u := range g.Vertices() {
+		sp, _ := Dijkstra(g, u, intWeight)
+		for _, v := range g.Vertices() {
+			expected, reachable := sp.DistanceTo(v)
+			actual, ok := m.Distance(u, v)
+			if ok != reachable || actual != expected {
+				t.Fatalf("%s:%s expected %d %t got %d %t", u, v, expected, reachable, actual, ok)
+			}
+			if !reachable {
+				unreachable++
+				if _, err := m.Path(u, v); !errors.Is(err, ErrNoPath) {
+					t.Fatalf("expected ErrNoPath got %v", err)
+				}
+				continue
+			}
+
+			p, err := m.Path(u, v)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			total := 0
+			for i, e := range p.Edges {
+				if e.Other(p.Vertices[i]) != p.Vertices[i+1] {
+					t.Fatalf("edge %v does not follow path %v", e, p.Vertices)
+				}
+				total += e.Data()
+			}
+			if p.Vertices[0] != u || p.Vertices[len(p.Vertices)-1] != v || total != expected {
+				t.Fatalf("unexpected path %v weight %d", p.Vertices, total)
+			}
+		}
+	}
+	if len(m.UnreachablePairs()) != unreachable {
+		t.Fatalf("expected %d unreachable pairs got %v", unreachable, m.UnreachablePairs())
+	}
+}
+
+func TestAllPairsNegativeWeights(t *testing.T) {
+	for _, allPairs := range []allPairsFunc{FloydWarshall[string, int, int], Johnson[string, int, int]} {
+		g := NewDirectedAdjacencyListGraph[string, int]()
+		for _, v := range []string{"a", "b", "c", "d"} {
+			g.AddVertex(v)
+		}
+		g.AddEdge("a", "b", 4)
+		g.AddEdge("a", "c", 2)
+		g.AddEdge("c", "b", -3)
+		g.AddEdge("b", "d", 1)
+
+		m, err := allPairs(g, intWeight)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if d, _ := m.Distance("a", "d"); d != 0 {
+			t.Fatalf("expected 0 got %d", d)
+		}
+		p, _ := m.Path("a", "d")
+		if !reflect.DeepEqual(p.Vertices, []string{"a", "c", "b", "d"}) {
+			t.Fatalf("unexpected path %v", p.Vertices)
+		}
+		if m.Reachable("d", "a") {
+			t.Fatal("expected d:a unreachable")
+		}
+
+		g.AddEdge("d", "c", 1)
+		_, err = allPairs(g, intWeight)
+		var cycle *NegativeCycleError[string, int]
+		if !errors.As(err, &cycle) {
+			t.Fatalf("expected negative cycle got %v", err)
+		}
+	}
+}
+
+func TestAllPairsUnknownVertex(t *testing.T) {
+	m, _ := FloydWarshall(weightedTestGraph(NewAdjacencyMatrixGraph[string, int]()), intWeight)
+	if _, err := m.Path("a", "z"); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+	if _, ok := m.Distance("z", "a"); ok {
+		t.Fatal("expected unknown vertex to be unreachable")
+	}
+}
@@ -0,0 +1,140 @@
+package graph
+
+// TieBreak decides which of several frontier vertices with the same
+// estimated total cost A* expands first
+type TieBreak int
+
+const (
+	// TieBreakFIFO expands the vertex that joined the frontier first
+	TieBreakFIFO TieBreak = iota
+	// TieBreakLIFO expands the vertex that joined the frontier last
+	TieBreakLIFO
+	// TieBreakHighG expands the vertex furthest from the source, which tends
+	// to be the one closest to the goal
+	TieBreakHighG
+	// TieBreakLowG expands the vertex closest to the source
+	TieBreakLowG
+)
+
+// AStarOptions for AStar. MaxExpansions stops the search once that many
+// vertices have been expanded, zero means no limit.
+type AStarOptions struct {
+	TieBreak      TieBreak
+	MaxExpansions int
+}
+
+// AStarStats describes the work done by a search. Reopened counts closed
+// vertices that were expanded again after a shorter path to them was found,
+// it stays zero when the heuristic is consistent.
+type AStarStats struct {
+	Expanded    int
+	Generated   int
+	Reopened    int
+	MaxFrontier int
+}
+
+type AStarResult[T comparable, D comparable, W Weight] struct {
+	Path  *Path[T, D, W]
+	Stats AStarStats
+}
+
+type astarItem[T comparable, W Weight] struct {
+	v   T
+	g   W
+	f   W
+	seq int
+}
+
+// AStar finds the shortest path from source to goal guided by heuristic, which
+// must never overestimate the remaining cost for the path to be optimal. The
+// search ends as soon as goal is expanded. The result is returned along with
+// ErrNoPath or ErrSearchLimit so the statistics of a failed search can still
+// be inspected.
+func AStar[T comparable, D comparable, W Weight](g Graph[T, D], source T, goal T, weight func(Edge[T, D]) W, heuristic func(v T, goal T) W, opts *AStarOptions) (*AStarResult[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	if !g.ContainsVertex(goal) {
+		return nil, vertexNotFound(goal)
+	}
+	if opts == nil {
+		opts = &AStarOptions{}
+	}
+
+	r := &AStarResult[T, D, W]{}
+	sp := &ShortestPaths[T, D, W]{
+		Source: source,
+		Dist:   map[T]W{source: 0},
+		Pred:   make(map[T]Edge[T, D]),
+	}
+	closed := make(map[T]bool)
+	pq := newPriorityQueue(astarLess[T, W](opts.TieBreak))
+	seq := 0
+	push := func(v T, d W) {
+		pq.push(astarItem[T, W]{v: v, g: d, f: d + heuristic(v, goal), seq: seq})
+		seq++
+		r.Stats.Generated++
+		if pq.Len() > r.Stats.MaxFrontier {
+			r.Stats.MaxFrontier = pq.Len()
+		}
+	}
+	push(source, 0)
+
+	for pq.Len() > 0 {
+		item := pq.pop()
+		if closed[item.v] || item.g > sp.Dist[item.v] {
+			continue
+		}
+		if item.v == goal {
+			path, err := sp.PathTo(goal)
+			r.Path = path
+			return r, err
+		}
+		if opts.MaxExpansions > 0 && r.Stats.Expanded >= opts.MaxExpansions {
+			return r, ErrSearchLimit
+		}
+		closed[item.v] = true
+		r.Stats.Expanded++
+
+		for _, e := range g.VectorEdges(item.v) {
+			w := weight(e)
+			if w < 0 {
+				return nil, &EdgeError[T]{U: e.u, V: e.v, Err: ErrNegativeWeight}
+			}
+			to := e.Other(item.v)
+			d := item.g + w
+			if current, ok := sp.Dist[to]; ok && d >= current {
+				continue
+			}
+			if closed[to] {
+				delete(closed, to)
+				r.Stats.Reopened++
+			}
+			sp.Dist[to] = d
+			sp.Pred[to] = e
+			push(to, d)
+		}
+	}
+	return r, &VertexError[T]{Vertex: goal, Err: ErrNoPath}
+}
+
+func astarLess[T comparable, W Weight](tieBreak TieBreak) func(a, b astarItem[T, W]) bool {
+	return func(a, b astarItem[T, W]) bool {
+		if a.f != b.f {
+			return a.f < b.f
+		}
+		switch tieBreak {
+		case TieBreakLIFO:
+			return a.seq > b.seq
+		case TieBreakHighG:
+			if a.g != b.g {
+				return a.g > b.g
+			}
+		case TieBreakLowG:
+			if a.g != b.g {
+				return a.g < b.g
+			}
+		}
+		return a.seq < b.seq
+	}
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+type cell struct {
+	x int
+	y int
+}
+
+// size x size grid with unit weights and a wall at x == 2 except for the top row
+func gridTestGraph(size int) Graph[cell, int] {
+	g := NewAdjacencyListGraph[cell, int]()
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			g.AddVertex(cell{x, y})
+		}
+	}
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			g.AddEdge(cell{x, y}, cell{x + 1, y}, 1)
+			g.AddEdge(cell{x, y}, cell{x, y + 1}, 1)
+		}
+	}
+	for y := 1; y < size; y++ {
+		g.RemoveVertex(cell{2, y})
+	}
+	return g
+}
+
+func manhattan(a cell, b cell) int {
+	dx, dy := a.x-b.x, a.y-b.y
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	return dx + dy
+}
+
+func cellWeight(e Edge[cell, int]) int {
+	return e.Data()
+}
+
+func TestAStar(t *testing.T) {
+	g := gridTestGraph(6)
+	source, goal := cell{0, 5}, cell{5, 5}
+
+	dijkstra, err := DijkstraPath(g, source, goal, cellWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for _, tieBreak := range []TieBreak{TieBreakFIFO, TieBreakLIFO, TieBreakHighG, TieBreakLowG} {
+		r, err := AStar(g, source, goal, cellWeight, manhattan, &AStarOptions{TieBreak: tieBreak})
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if r.Path.Weight != dijkstra.Weight {
+			t.Fatalf("tie break %d expected %d got %d", tieBreak, dijkstra.Weight, r.Path.Weight)
+		}
+		if r.Path.Vertices[0] != source || r.Path.Vertices[len(r.Path.Vertices)-1] != goal {
+			t.Fatalf("unexpected path %v", r.Path.Vertices)
+		}
+		if r.Stats.Expanded == 0 || r.Stats.Generated < r.Stats.Expanded || r.Stats.Reopened != 0 {
+			t.Fatalf("unexpected stats %+v", r.Stats)
+		}
+	}
+
+	zero := func(cell, cell) int { return 0 }
+	source = cell{3, 5}
+	uninformed, _ := AStar(g, source, goal, cellWeight, zero, nil)
+	informed, _ := AStar(g, source, goal, cellWeight, manhattan, nil)
+	if informed.Stats.Expanded >= uninformed.Stats.Expanded {
+		t.Fatalf("expected heuristic to expand fewer vertices, %d >= %d", informed.Stats.Expanded, uninformed.Stats.Expanded)
+	}
+}
+
+func TestAStarLimits(t *testing.T) {
+	g := gridTestGraph(6)
+	source, goal := cell{0, 5}, cell{5, 5}
+
+	r, err := AStar(g, source, goal, cellWeight, manhattan, &AStarOptions{MaxExpansions: 3})
+	if !errors.Is(err, ErrSearchLimit) || r.Stats.Expanded != 3 || r.Path != nil {
+		t.Fatalf("expected search limit after 3 expansions got %v %+v", err, r)
+	}
+
+	g.RemoveEdge(NewEdge(cell{2, 0}, cell{3, 0}, 0))
+	r, err = AStar(g, source, goal, cellWeight, manhattan, nil)
+	if !errors.Is(err, ErrNoPath) || r.Stats.Expanded == 0 {
+		t.Fatalf("expected ErrNoPath with stats got %v %+v", err, r)
+	}
+}
@@ -0,0 +1,161 @@
+package graph
+
+import "slices"
+
+// arc is an edge followed in one direction, undirected edges give two arcs
+type arc[T comparable, D comparable] struct {
+	from T
+	to   T
+	e    Edge[T, D]
+}
+
+func arcs[T comparable, D comparable](g Graph[T, D]) []arc[T, D] {
+	r := make([]arc[T, D], 0)
+	for _, u := range g.Vertices() {
+		for _, e := range g.VectorEdges(u) {
+			r = append(r, arc[T, D]{u, e.Other(u), e})
+		}
+	}
+	return r
+}
+
+// BellmanFord computes shortest paths from source allowing negative weights.
+// A negative cycle reachable from source is returned as a
+// NegativeCycleError, for undirected graphs any negative edge is such a cycle.
+func BellmanFord[T comparable, D comparable, W Weight](g Graph[T, D], source T, weight func(Edge[T, D]) W) (*ShortestPaths[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	sp := &ShortestPaths[T, D, W]{
+		Source: source,
+		Dist:   map[T]W{source: 0},
+		Pred:   make(map[T]Edge[T, D]),
+	}
+	if err := bellmanFord(g, sp, edgeWeight(weight)); err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// bellmanFord relaxes every arc until sp.Dist settles, starting from whatever
+// distances sp already holds
+func bellmanFord[T comparable, D comparable, W Weight](g Graph[T, D], sp *ShortestPaths[T, D, W], weight arcWeight[T, D, W]) error {
+	all := arcs(g)
+	n := len(g.Vertices())
+	for i := 0; i < n; i++ {
+		changed := false
+		for _, a := range all {
+			du, ok := sp.Dist[a.from]
+			if !ok {
+				continue
+			}
+			d := du + weight(a.from, a.e)
+			if dv, ok := sp.Dist[a.to]; !ok || d < dv {
+				sp.Dist[a.to] = d
+				sp.Pred[a.to] = a.e
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+	// still relaxing after n rounds, the predecessor graph holds a cycle
+	return predecessorCycle(sp.Pred)
+}
+
+// SPFA is the queue based variant of BellmanFord, it only rescans vertices
+// whose distance changed which is usually much faster on sparse graphs
+func SPFA[T comparable, D comparable, W Weight](g Graph[T, D], source T, weight func(Edge[T, D]) W) (*ShortestPaths[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	sp := &ShortestPaths[T, D, W]{
+		Source: source,
+		Dist:   map[T]W{source: 0},
+		Pred:   make(map[T]Edge[T, D]),
+	}
+	n := len(g.Vertices())
+	length := map[T]int{source: 0}
+	queued := map[T]bool{source: true}
+	queue := []T{source}
+
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		queued[u] = false
+
+		for _, e := range g.VectorEdges(u) {
+			to := e.Other(u)
+			d := sp.Dist[u] + weight(e)
+			if dv, ok := sp.Dist[to]; ok && d >= dv {
+				continue
+			}
+			sp.Dist[to] = d
+			sp.Pred[to] = e
+			length[to] = length[u] + 1
+			// a shortest path never has n edges, once one does the
+			// predecessor graph will eventually close a cycle
+			if length[to] >= n {
+				if err := predecessorCycle(sp.Pred); err != nil {
+					return nil, err
+				}
+			}
+			if !queued[to] {
+				queued[to] = true
+				queue = append(queue, to)
+			}
+		}
+	}
+	return sp, nil
+}
+
+// predecessorCycle looks for a cycle in the graph formed by following Pred
+// edges, during label correcting any such cycle has negative weight
+func predecessorCycle[T comparable, D comparable](pred map[T]Edge[T, D]) error {
+	const (
+		unvisited = iota
+		onWalk
+		done
+	)
+	state := make(map[T]int)
+	for start := range pred {
+		walk := make([]T, 0)
+		v := start
+		closed := false
+		for {
+			if state[v] != unvisited {
+				closed = state[v] == onWalk
+				break
+			}
+			state[v] = onWalk
+			walk = append(walk, v)
+			e, ok := pred[v]
+			if !ok {
+				break
+			}
+			v = e.Other(v)
+		}
+
+		if closed {
+			cycle := &NegativeCycleError[T, D]{Vertices: []T{v}}
+			for u := v; ; {
+				e := pred[u]
+				u = e.Other(u)
+				cycle.Vertices = append(cycle.Vertices, u)
+				cycle.Edges = append(cycle.Edges, e)
+				if u == v {
+					break
+				}
+			}
+			slices.Reverse(cycle.Vertices)
+			slices.Reverse(cycle.Edges)
+			return cycle
+		}
+
+		for _, u := range walk {
+			state[u] = done
+		}
+	}
+	return nil
+}
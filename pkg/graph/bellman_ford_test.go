@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type shortestPathFunc func(Graph[string, int], string, func(Edge[string, int]) int) (*ShortestPaths[string, int, int], error)
+
+func TestBellmanFord(t *testing.T) {
+	negativeWeightTest(BellmanFord[string, int, int], t)
+	negativeWeightTest(SPFA[string, int, int], t)
+}
+
+func negativeWeightTest(shortestPaths shortestPathFunc, t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[string, int]()
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 2)
+	g.AddEdge("c", "b", -3)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("e", "a", 1)
+
+	sp, err := shortestPaths(g, "a", intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := map[string]int{"a": 0, "b": -1, "c": 2, "d": 0}
+	if !reflect.DeepEqual(sp.Dist, expected) {
+		t.Fatalf("expected %v got %v", expected, sp.Dist)
+	}
+	p, _ := sp.PathTo("d")
+	if !reflect.DeepEqual(p.Vertices, []string{"a", "c", "b", "d"}) {
+		t.Fatalf("unexpected path %v", p.Vertices)
+	}
+
+	// d -> c closes c -> b -> d with weight -3 + 1 + 1
+	g.AddEdge("d", "c", 1)
+	_, err = shortestPaths(g, "a", intWeight)
+	var cycle *NegativeCycleError[string, int]
+	if !errors.Is(err, ErrNegativeCycle) || !errors.As(err, &cycle) {
+		t.Fatalf("expected negative cycle got %v", err)
+	}
+	if len(cycle.Vertices) != 4 || cycle.Vertices[0] != cycle.Vertices[3] || len(cycle.Edges) != 3 {
+		t.Fatalf("unexpected cycle %v", cycle.Vertices)
+	}
+	total := 0
+	for i, e := range cycle.Edges {
+		if e.U() != cycle.Vertices[i] || e.V() != cycle.Vertices[i+1] {
+			t.Fatalf("edge %v does not follow cycle %v", e, cycle.Vertices)
+		}
+		total += e.Data()
+	}
+	if total >= 0 {
+		t.Fatalf("expected negative cycle weight got %d", total)
+	}
+
+	// a negative cycle the source cannot reach is not reported
+	g2 := NewDirectedAdjacencyListGraph[string, int]()
+	g2.AddVertex("x")
+	g2.AddVertex("y")
+	g2.AddVertex("z")
+	g2.AddEdge("x", "y", -1)
+	g2.AddEdge("y", "x", -1)
+	if _, err := shortestPaths(g2, "z", intWeight); err != nil {
+		t.Fatalf("unexpected error for unreachable cycle %v", err)
+	}
+}
+
+func TestBellmanFordUndirectedNegativeEdge(t *testing.T) {
+	g := weightedTestGraph(NewAdjacencyListGraph[string, int]())
+	if _, err := BellmanFord(g, "a", intWeight); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	g.AddEdge("d", "e", -2)
+	_, err := SPFA(g, "a", intWeight)
+	var cycle *NegativeCycleError[string, int]
+	if !errors.As(err, &cycle) || len(cycle.Edges) != 2 || cycle.Edges[0] != cycle.Edges[1] {
+		t.Fatalf("expected the negative edge walked both ways got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"mervynrussell/gocol/pkg/unionfind"
+)
+
+// biconnectivity is everything found by one lowpoint DFS over an undirected
+// graph
+type biconnectivity[T comparable, D comparable] struct {
+	articulation set.Set[T]
+	bridges      []Edge[T, D]
+	components   []set.Set[Edge[T, D]]
+}
+
+func lowpoint[T comparable, D comparable](g Graph[T, D]) (*biconnectivity[T, D], error) {
+	if _, ok := g.(DirectedGraph[T, D]); ok {
+		return nil, ErrDirected
+	}
+	b := &biconnectivity[T, D]{
+		articulation: set.New[T](),
+		bridges:      make([]Edge[T, D], 0),
+		components:   make([]set.Set[Edge[T, D]], 0),
+	}
+	disc := make(map[T]int)
+	low := make(map[T]int)
+	parent := make(map[T]T)
+	parentEdge := make(map[T]Edge[T, D])
+	children := make(map[T]int)
+	edges := make([]Edge[T, D], 0)
+
+	vis := &Visitor[T, D]{
+		DiscoverVertex: func(v T) bool {
+			disc[v] = len(disc)
+			low[v] = disc[v]
+			return true
+		},
+		TreeEdge: func(from T, e Edge[T, D]) bool {
+			w := e.Other(from)
+			parent[w] = from
+			parentEdge[w] = e
+			children[from]++
+			edges = append(edges, e)
+			return true
+		},
+		BackEdge: func(from T, e Edge[T, D]) bool {
+			if d := disc[e.Other(from)]; d < low[from] {
+				low[from] = d
+			}
+			edges = append(edges, e)
+			return true
+		},
+		FinishVertex: func(v T) bool {
+			p, ok := parent[v]
+			if !ok {
+				return true
+			}
+			if low[v] < low[p] {
+				low[p] = low[v]
+			}
+			if low[v] > disc[p] {
+				b.bridges = append(b.bridges, parentEdge[v])
+			}
+			if low[v] >= disc[p] {
+				// a root is only an articulation point with two children,
+				// checked once the whole tree is known
+				if _, ok := parent[p]; ok {
+					b.articulation.Add(p)
+				}
+				component := set.New[Edge[T, D]]()
+				for {
+					e := edges[len(edges)-1]
+					edges = edges[:len(edges)-1]
+					component.Add(e)
+					if e == parentEdge[v] {
+						break
+					}
+				}
+				b.components = append(b.components, component)
+			}
+			return true
+		},
+	}
+	DFSForest(g, vis, nil)
+
+	for v, n := range children {
+		if _, ok := parent[v]; !ok && n > 1 {
+			b.articulation.Add(v)
+		}
+	}
+	return b, nil
+}
+
+// ArticulationPoints returns the vertices of an undirected graph whose removal
+// disconnects part of the graph
+func ArticulationPoints[T comparable, D comparable](g Graph[T, D]) (set.Set[T], error) {
+	b, err := lowpoint(g)
+	if err != nil {
+		return nil, err
+	}
+	return b.articulation, nil
+}
+
+// Bridges returns the edges of an undirected graph whose removal disconnects
+// part of the graph
+func Bridges[T comparable, D comparable](g Graph[T, D]) ([]Edge[T, D], error) {
+	b, err := lowpoint(g)
+	if err != nil {
+		return nil, err
+	}
+	return b.bridges, nil
+}
+
+// BiconnectedComponents partitions the edges of an undirected graph into
+// maximal sets that stay connected after removing any one vertex. A bridge is
+// a component of its own.
+func BiconnectedComponents[T comparable, D comparable](g Graph[T, D]) ([]set.Set[Edge[T, D]], error) {
+	b, err := lowpoint(g)
+	if err != nil {
+		return nil, err
+	}
+	return b.components, nil
+}
+
+// TwoEdgeConnectedComponents partitions the vertices of an undirected graph
+// into maximal sets that stay connected after removing any one edge, they are
+// what is left joined once every bridge is removed
+func TwoEdgeConnectedComponents[T comparable, D comparable](g Graph[T, D]) (*Components[T], error) {
+	b, err := lowpoint(g)
+	if err != nil {
+		return nil, err
+	}
+	bridges := set.NewFrom(b.bridges)
+	uf := unionfind.NewFrom(g.Vertices())
+	for _, e := range g.Edges() {
+		if !bridges.Contains(e) {
+			uf.Union(e.u, e.v)
+		}
+	}
+	return newComponents(uf.Sets()), nil
+}
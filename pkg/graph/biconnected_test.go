@@ -0,0 +1,85 @@
+package graph
+
+import (
+	"errors"
+	"mervynrussell/gocol/pkg/set"
+	"slices"
+	"sort"
+	"testing"
+)
+
+// triangle 0-1-2 joined through the bridge 2-3 to the square 3-4-5-6, which
+// shares vertex 3 with the triangle 3-7-8, and 9 hanging off 8
+func biconnectedTestGraph() Graph[int, string] {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 10; i++ {
+		g.AddVertex(i)
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 5}, {5, 6}, {6, 3}, {3, 7}, {7, 8}, {8, 3}, {8, 9}} {
+		g.AddEdge(e[0], e[1], "")
+	}
+	return g
+}
+
+func TestArticulationPoints(t *testing.T) {
+	points, err := ArticulationPoints(biconnectedTestGraph())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !points.Equals(set.NewFrom([]int{2, 3, 8})) {
+		t.Fatalf("expected [2 3 8] got %v", points.All())
+	}
+}
+
+func TestBridges(t *testing.T) {
+	g := biconnectedTestGraph()
+	bridges, err := Bridges(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(bridges) != 2 {
+		t.Fatalf("expected 2 got %v", bridges)
+	}
+	for _, e := range bridges {
+		if !g.ContainsEdge(e) || !(e == NewEdge(2, 3, "") || e == NewEdge(8, 9, "")) {
+			t.Fatalf("unexpected bridge %v", e)
+		}
+	}
+}
+
+func TestBiconnectedComponents(t *testing.T) {
+	g := biconnectedTestGraph()
+	components, err := BiconnectedComponents(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	sizes := make([]int, 0)
+	total := 0
+	for _, c := range components {
+		sizes = append(sizes, c.Len())
+		total += c.Len()
+	}
+	sort.Ints(sizes)
+	if !slices.Equal(sizes, []int{1, 1, 3, 3, 4}) || total != len(g.Edges()) {
+		t.Fatalf("expected components of 1, 1, 3, 3 and 4 edges got %v", sizes)
+	}
+}
+
+func TestTwoEdgeConnectedComponents(t *testing.T) {
+	c, err := TwoEdgeConnectedComponents(biconnectedTestGraph())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// 0-1-2, 3-4-5-6-7-8, 9 and 10
+	if c.Len() != 4 {
+		t.Fatalf("expected 4 got %d", c.Len())
+	}
+	c3, _ := c.ComponentOf(3)
+	if !c3.Equals(set.NewFrom([]int{3, 4, 5, 6, 7, 8})) {
+		t.Fatalf("unexpected component of 3 %v", c3.All())
+	}
+
+	if _, err := TwoEdgeConnectedComponents[int, string](NewDirectedAdjacencyListGraph[int, string]()); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+}
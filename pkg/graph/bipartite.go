@@ -0,0 +1,333 @@
+package graph
+
+import (
+	"math"
+	"mervynrussell/gocol/pkg/set"
+	"slices"
+)
+
+// IsBipartite two-colours g, returning the two sides. If g is not bipartite an
+// OddCycleError is returned as the witness. Directed graphs are coloured as if
+// undirected.
+func IsBipartite[T comparable, D comparable](g Graph[T, D]) (set.Set[T], set.Set[T], error) {
+	colour := make(map[T]bool)
+	parentEdge := make(map[T]Edge[T, D])
+	depth := make(map[T]int)
+
+	for _, root := range g.Vertices() {
+		if _, ok := colour[root]; ok {
+			continue
+		}
+		colour[root] = false
+		depth[root] = 0
+		queue := []T{root}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, e := range incidentEdges(g, u) {
+				w := e.Other(u)
+				c, ok := colour[w]
+				if !ok {
+					colour[w] = !colour[u]
+					depth[w] = depth[u] + 1
+					parentEdge[w] = e
+					queue = append(queue, w)
+					continue
+				}
+				if c == colour[u] {
+					return nil, nil, oddCycle(u, w, e, parentEdge, depth)
+				}
+			}
+		}
+	}
+
+	left, right := set.New[T](), set.New[T]()
+	for v, c := range colour {
+		if c {
+			right.Add(v)
+		} else {
+			left.Add(v)
+		}
+	}
+	return left, right, nil
+}
+
+// oddCycle joins the BFS tree paths from u and w to their common ancestor
+// with the edge e between them
+func oddCycle[T comparable, D comparable](u T, w T, e Edge[T, D], parentEdge map[T]Edge[T, D], depth map[T]int) *OddCycleError[T, D] {
+	up := func(v T) (T, Edge[T, D]) {
+		pe := parentEdge[v]
+		return pe.Other(v), pe
+	}
+	fromU, fromW := []T{u}, []T{w}
+	edgesU, edgesW := make([]Edge[T, D], 0), make([]Edge[T, D], 0)
+	for u != w {
+		var pe Edge[T, D]
+		if depth[u] >= depth[w] {
+			u, pe = up(u)
+			fromU = append(fromU, u)
+			edgesU = append(edgesU, pe)
+		} else {
+			w, pe = up(w)
+			fromW = append(fromW, w)
+			edgesW = append(edgesW, pe)
+		}
+	}
+
+	// ancestor ... u, w ... ancestor
+	slices.Reverse(fromU)
+	slices.Reverse(edgesU)
+	cycle := &OddCycleError[T, D]{
+		Vertices: append(fromU, fromW...),
+		Edges:    append(append(edgesU, e), edgesW...),
+	}
+	return cycle
+}
+
+// Matching is a set of edges no two of which share a vertex
+type Matching[T comparable, D comparable] struct {
+	Edges []Edge[T, D]
+	mate  map[T]T
+}
+
+func newMatching[T comparable, D comparable]() *Matching[T, D] {
+	return &Matching[T, D]{Edges: make([]Edge[T, D], 0), mate: make(map[T]T)}
+}
+
+func (m *Matching[T, D]) add(e Edge[T, D]) {
+	m.Edges = append(m.Edges, e)
+	m.mate[e.u] = e.v
+	m.mate[e.v] = e.u
+}
+
+// Mate returns the vertex v is matched with
+func (m *Matching[T, D]) Mate(v T) (T, bool) {
+	w, ok := m.mate[v]
+	return w, ok
+}
+
+// Len return number of matched edges
+func (m *Matching[T, D]) Len() int {
+	return len(m.Edges)
+}
+
+// bipartiteIndex numbers the two sides of a bipartite graph, adj holds for
+// each left vertex the right vertices it has an edge to
+type bipartiteIndex[T comparable, D comparable] struct {
+	left  []T
+	right []T
+	adj   [][]int
+	edges [][]Edge[T, D]
+}
+
+func newBipartiteIndex[T comparable, D comparable](g Graph[T, D]) (*bipartiteIndex[T, D], error) {
+	leftSet, rightSet, err := IsBipartite(g)
+	if err != nil {
+		return nil, err
+	}
+	b := &bipartiteIndex[T, D]{left: leftSet.All(), right: rightSet.All()}
+	rightIndex := make(map[T]int, len(b.right))
+	for j, v := range b.right {
+		rightIndex[v] = j
+	}
+	b.adj = make([][]int, len(b.left))
+	b.edges = make([][]Edge[T, D], len(b.left))
+	for i, u := range b.left {
+		for _, e := range incidentEdges(g, u) {
+			b.adj[i] = append(b.adj[i], rightIndex[e.Other(u)])
+			b.edges[i] = append(b.edges[i], e)
+		}
+	}
+	return b, nil
+}
+
+// MaximumMatching finds a maximum cardinality matching of a bipartite graph
+// with the Hopcroft-Karp algorithm in O(E sqrt(V)). A graph that is not
+// bipartite returns an OddCycleError.
+func MaximumMatching[T comparable, D comparable](g Graph[T, D]) (*Matching[T, D], error) {
+	b, err := newBipartiteIndex(g)
+	if err != nil {
+		return nil, err
+	}
+
+	const free = -1
+	matchLeft := make([]int, len(b.left))
+	matchRight := make([]int, len(b.right))
+	for i := range matchLeft {
+		matchLeft[i] = free
+	}
+	for j := range matchRight {
+		matchRight[j] = free
+	}
+	dist := make([]int, len(b.left))
+
+	// layer the free left vertices and everything reachable from them by
+	// alternating paths, true if a free right vertex was reached
+	bfs := func() bool {
+		queue := make([]int, 0)
+		for i := range b.left {
+			if matchLeft[i] == free {
+				dist[i] = 0
+				queue = append(queue, i)
+			} else {
+				dist[i] = math.MaxInt
+			}
+		}
+		found := false
+		for len(queue) > 0 {
+			i := queue[0]
+			queue = queue[1:]
+			for _, j := range b.adj[i] {
+				next := matchRight[j]
+				if next == free {
+					found = true
+				} else if dist[next] == math.MaxInt {
+					dist[next] = dist[i] + 1
+					queue = append(queue, next)
+				}
+			}
+		}
+		return found
+	}
+
+	var dfs func(i int) bool
+	dfs = func(i int) bool {
+		for _, j := range b.adj[i] {
+			next := matchRight[j]
+			if next == free || (dist[next] == dist[i]+1 && dfs(next)) {
+				matchLeft[i] = j
+				matchRight[j] = i
+				return true
+			}
+		}
+		dist[i] = math.MaxInt
+		return false
+	}
+
+	for bfs() {
+		for i := range b.left {
+			if matchLeft[i] == free {
+				dfs(i)
+			}
+		}
+	}
+
+	m := newMatching[T, D]()
+	for i, j := range matchLeft {
+		if j == free {
+			continue
+		}
+		for k, e := range b.edges[i] {
+			if b.adj[i][k] == j {
+				m.add(e)
+				break
+			}
+		}
+	}
+	return m, nil
+}
+
+// MinimumWeightAssignment matches the two sides of a bipartite graph using the
+// Hungarian algorithm in O(V^3). It finds the largest matching possible and,
+// of those, one with the least total weight, which is returned with it. To
+// maximise weight negate it. A graph that is not bipartite returns an
+// OddCycleError.
+func MinimumWeightAssignment[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (*Matching[T, D], W, error) {
+	b, err := newBipartiteIndex(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// the algorithm needs rows <= columns
+	rows, cols := len(b.left), len(b.right)
+	transposed := rows > cols
+	if transposed {
+		rows, cols = cols, rows
+	}
+
+	// a missing edge costs more than every real edge together so a matching
+	// never gives up a real edge to save weight
+	missing := 1.0
+	cost := make([][]float64, rows+1)
+	edge := make([][]*Edge[T, D], rows+1)
+	for i := range cost {
+		cost[i] = make([]float64, cols+1)
+		edge[i] = make([]*Edge[T, D], cols+1)
+	}
+	for i := range b.left {
+		for k, j := range b.adj[i] {
+			e := b.edges[i][k]
+			r, c := i+1, j+1
+			if transposed {
+				r, c = c, r
+			}
+			cost[r][c] = float64(weight(e))
+			edge[r][c] = &e
+			missing += math.Abs(cost[r][c])
+		}
+	}
+	for r := 1; r <= rows; r++ {
+		for c := 1; c <= cols; c++ {
+			if edge[r][c] == nil {
+				cost[r][c] = missing
+			}
+		}
+	}
+
+	// potentials u for rows and v for columns, p[c] is the row assigned to
+	// column c with index 0 as a sentinel
+	u := make([]float64, rows+1)
+	v := make([]float64, cols+1)
+	p := make([]int, cols+1)
+	way := make([]int, cols+1)
+	for i := 1; i <= rows; i++ {
+		p[0] = i
+		c0 := 0
+		minv := make([]float64, cols+1)
+		used := make([]bool, cols+1)
+		for c := range minv {
+			minv[c] = math.Inf(1)
+		}
+		for p[c0] != 0 {
+			used[c0] = true
+			r0, delta, c1 := p[c0], math.Inf(1), 0
+			for c := 1; c <= cols; c++ {
+				if used[c] {
+					continue
+				}
+				if cur := cost[r0][c] - u[r0] - v[c]; cur < minv[c] {
+					minv[c] = cur
+					way[c] = c0
+				}
+				if minv[c] < delta {
+					delta = minv[c]
+					c1 = c
+				}
+			}
+			for c := 0; c <= cols; c++ {
+				if used[c] {
+					u[p[c]] += delta
+					v[c] -= delta
+				} else {
+					minv[c] -= delta
+				}
+			}
+			c0 = c1
+		}
+		for c0 != 0 {
+			c1 := way[c0]
+			p[c0] = p[c1]
+			c0 = c1
+		}
+	}
+
+	var total W
+	m := newMatching[T, D]()
+	for c := 1; c <= cols; c++ {
+		if e := edge[p[c]][c]; p[c] != 0 && e != nil {
+			m.add(*e)
+			total += weight(*e)
+		}
+	}
+	return m, total, nil
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsBipartite(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 6; i++ {
+		g.AddVertex(i)
+	}
+	// even cycle 0-1-2-3 with a tail 3-4 and 5-6 apart
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+	g.AddEdge(2, 3, "2:3")
+	g.AddEdge(3, 0, "3:0")
+	g.AddEdge(3, 4, "3:4")
+	g.AddEdge(5, 6, "5:6")
+
+	left, right, err := IsBipartite[int, string](g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if left.Len()+right.Len() != 7 {
+		t.Fatal("expected every vertex coloured")
+	}
+	for _, e := range g.Edges() {
+		if left.Contains(e.U()) == left.Contains(e.V()) {
+			t.Fatalf("%v joins two vertices on the same side", e)
+		}
+	}
+
+	// 4-0 closes odd cycles such as 0-3-4
+	g.AddEdge(4, 0, "4:0")
+	_, _, err = IsBipartite[int, string](g)
+	var cycle *OddCycleError[int, string]
+	if !errors.Is(err, ErrNotBipartite) || !errors.As(err, &cycle) {
+		t.Fatalf("expected odd cycle got %v", err)
+	}
+	n := len(cycle.Vertices)
+	if n%2 != 0 || cycle.Vertices[0] != cycle.Vertices[n-1] || len(cycle.Edges) != n-1 {
+		t.Fatalf("expected odd cycle got %v", cycle.Vertices)
+	}
+	for i, e := range cycle.Edges {
+		if e.Other(cycle.Vertices[i]) != cycle.Vertices[i+1] || !g.ContainsEdge(e) {
+			t.Fatalf("edge %v does not follow cycle %v", e, cycle.Vertices)
+		}
+	}
+}
+
+// workers w1..w4 and jobs j1..j4, w4 can only do j1
+func assignmentTestGraph() Graph[string, int] {
+	g := NewAdjacencyListGraph[string, int]()
+	for _, v := range []string{"w1", "w2", "w3", "w4", "j1", "j2", "j3", "j4"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("w1", "j1", 9)
+	g.AddEdge("w1", "j2", 2)
+	g.AddEdge("w1", "j3", 7)
+	g.AddEdge("w2", "j1", 6)
+	g.AddEdge("w2", "j2", 4)
+	g.AddEdge("w2", "j3", 3)
+	g.AddEdge("w3", "j1", 5)
+	g.AddEdge("w3", "j2", 8)
+	g.AddEdge("w3", "j3", 1)
+	g.AddEdge("w3", "j4", 8)
+	g.AddEdge("w4", "j1", 7)
+	return g
+}
+
+func checkMatching(g Graph[string, int], m *Matching[string, int], t *testing.T) {
+	used := make(map[string]bool)
+	for _, e := range m.Edges {
+		if used[e.U()] || used[e.V()] || !g.ContainsEdge(e) {
+			t.Fatalf("invalid matching %v", m.Edges)
+		}
+		used[e.U()], used[e.V()] = true, true
+		if mate, _ := m.Mate(e.U()); mate != e.V() {
+			t.Fatalf("expected mate of %s to be %s", e.U(), e.V())
+		}
+	}
+}
+
+func TestMaximumMatching(t *testing.T) {
+	g := assignmentTestGraph()
+	m, err := MaximumMatching(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if m.Len() != 4 {
+		t.Fatalf("expected 4 got %d", m.Len())
+	}
+	checkMatching(g, m, t)
+
+	g.RemoveVertex("j4")
+	m, _ = MaximumMatching(g)
+	if m.Len() != 3 {
+		t.Fatalf("expected 3 got %d", m.Len())
+	}
+	checkMatching(g, m, t)
+
+	g.AddEdge("w1", "w2", 0)
+	g.AddEdge("w2", "w3", 0)
+	g.AddEdge("w3", "w1", 0)
+	if _, err := MaximumMatching(g); !errors.Is(err, ErrNotBipartite) {
+		t.Fatalf("expected ErrNotBipartite got %v", err)
+	}
+}
+
+func TestMinimumWeightAssignment(t *testing.T) {
+	g := assignmentTestGraph()
+	m, total, err := MinimumWeightAssignment(g, intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkMatching(g, m, t)
+	// w4 must take j1 and w3 is the only one for j4, leaving w1:j2 and w2:j3
+	if m.Len() != 4 || total != 7+8+2+3 {
+		t.Fatalf("expected 4 edges weighing 20 got %d weighing %d", m.Len(), total)
+	}
+
+	// more workers than jobs
+	g.RemoveVertex("j4")
+	g.RemoveVertex("j3")
+	m, total, _ = MinimumWeightAssignment(g, intWeight)
+	checkMatching(g, m, t)
+	if m.Len() != 2 || total != 2+5 {
+		t.Fatalf("expected 2 edges weighing 7 got %d weighing %d", m.Len(), total)
+	}
+
+	maxWeight := func(e Edge[string, int]) float64 { return -float64(e.Data()) }
+	m, best, _ := MinimumWeightAssignment(g, maxWeight)
+	if m.Len() != 2 || best != -17 {
+		t.Fatalf("expected maximum weight 17 got %v", -best)
+	}
+}
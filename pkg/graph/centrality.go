@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// CentralityOptions for the iterative measures, EigenvectorCentrality and
+// PageRank. Zero values fall back to a damping of 0.85, a tolerance of 1e-6
+// and 100 iterations. Iteration stops once the total change across all
+// vertices drops below Tolerance times the number of vertices.
+type CentralityOptions struct {
+	Damping       float64
+	Tolerance     float64
+	MaxIterations int
+}
+
+func (o *CentralityOptions) withDefaults() CentralityOptions {
+	r := CentralityOptions{Damping: 0.85, Tolerance: 1e-6, MaxIterations: 100}
+	if o != nil {
+		if o.Damping > 0 {
+			r.Damping = o.Damping
+		}
+		if o.Tolerance > 0 {
+			r.Tolerance = o.Tolerance
+		}
+		if o.MaxIterations > 0 {
+			r.MaxIterations = o.MaxIterations
+		}
+	}
+	return r
+}
+
+// DegreeCentrality is the number of edges at each vertex, incoming and
+// outgoing for directed graphs, divided by the number of other vertices
+func DegreeCentrality[T comparable, D comparable](g Graph[T, D]) map[T]float64 {
+	vertices := g.Vertices()
+	r := make(map[T]float64, len(vertices))
+	if len(vertices) == 1 {
+		r[vertices[0]] = 1
+		return r
+	}
+	for _, v := range vertices {
+		r[v] = float64(len(incidentEdges(g, v))) / float64(len(vertices)-1)
+	}
+	return r
+}
+
+// ClosenessCentrality is the inverse of the average hop distance from each
+// vertex to the ones it can reach, scaled by the fraction of the graph it can
+// reach so a vertex in a small component does not score highly (Wasserman and
+// Faust). Directed graphs are measured along outgoing edges.
+func ClosenessCentrality[T comparable, D comparable](g Graph[T, D]) map[T]float64 {
+	n := len(g.Vertices())
+	return hopCentrality(g, func(depth map[T]int) float64 {
+		total := 0
+		for _, d := range depth {
+			total += d
+		}
+		if total == 0 {
+			return 0
+		}
+		reached := float64(len(depth) - 1)
+		return reached / float64(total) * reached / float64(n-1)
+	})
+}
+
+// HarmonicCentrality is the sum of the inverse hop distances from each vertex
+// to every other, divided by the number of other vertices. Unreachable
+// vertices contribute zero so unlike closeness it needs no correction for
+// disconnected graphs. Directed graphs are measured along outgoing edges.
+func HarmonicCentrality[T comparable, D comparable](g Graph[T, D]) map[T]float64 {
+	n := len(g.Vertices())
+	return hopCentrality(g, func(depth map[T]int) float64 {
+		total := 0.0
+		for _, d := range depth {
+			if d > 0 {
+				total += 1 / float64(d)
+			}
+		}
+		return total / float64(n-1)
+	})
+}
+
+// hopCentrality scores every vertex from the BFS depths of the vertices it
+// can reach
+func hopCentrality[T comparable, D comparable](g Graph[T, D], score func(depth map[T]int) float64) map[T]float64 {
+	vertices := g.Vertices()
+	r := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		if len(vertices) < 2 {
+			r[v] = 0
+			continue
+		}
+		t, _ := BFS(g, v, nil, nil)
+		r[v] = score(t.Depth)
+	}
+	return r
+}
+
+// BetweennessCentrality is the fraction of shortest paths between other pairs
+// of vertices that pass through each vertex, counting hops. Paths of equal
+// length share the credit. Scores are normalised by the number of pairs so
+// they fall between 0 and 1.
+func BetweennessCentrality[T comparable, D comparable](g Graph[T, D]) map[T]float64 {
+	r, _ := brandes(g, func(Edge[T, D]) int { return 1 })
+	return r
+}
+
+// WeightedBetweennessCentrality is BetweennessCentrality with path length
+// measured by weight. Weights must not be negative, the first negative weight
+// seen is reported as an EdgeError wrapping ErrNegativeWeight.
+func WeightedBetweennessCentrality[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (map[T]float64, error) {
+	return brandes(g, weight)
+}
+
+// brandes accumulates the dependency of every source on each vertex from a
+// single Dijkstra per source, O(VE log V)
+func brandes[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (map[T]float64, error) {
+	type item struct {
+		v    T
+		dist W
+	}
+
+	vertices := g.Vertices()
+	r := make(map[T]float64, len(vertices))
+	for _, v := range vertices {
+		r[v] = 0
+	}
+
+	for _, s := range vertices {
+		dist := map[T]W{s: 0}
+		sigma := map[T]float64{s: 1}
+		pred := make(map[T][]T)
+		settled := make(map[T]bool)
+		// vertices in the order settled, so by non decreasing distance
+		order := make([]T, 0, len(vertices))
+
+		pq := newPriorityQueue(func(a, b item) bool { return a.dist < b.dist })
+		pq.push(item{s, 0})
+		for pq.Len() > 0 {
+			it := pq.pop()
+			u := it.v
+			if settled[u] {
+				continue
+			}
+			settled[u] = true
+			order = append(order, u)
+
+			for _, e := range g.VectorEdges(u) {
+				w := weight(e)
+				if w < 0 {
+					return nil, &EdgeError[T]{U: e.U(), V: e.V(), Err: ErrNegativeWeight}
+				}
+				v := e.Other(u)
+				d := it.dist + w
+				old, seen := dist[v]
+				switch {
+				case !seen || d < old:
+					dist[v] = d
+					sigma[v] = sigma[u]
+					pred[v] = []T{u}
+					pq.push(item{v, d})
+				case d == old && !settled[v]:
+					sigma[v] += sigma[u]
+					pred[v] = append(pred[v], u)
+				}
+			}
+		}
+
+		delta := make(map[T]float64, len(order))
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range pred[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != s {
+				r[w] += delta[w]
+			}
+		}
+	}
+
+	// every ordered pair was counted, which for undirected graphs counts
+	// each path twice, so both cases share the same scale
+	if n := len(vertices); n > 2 {
+		scale := 1 / float64((n-1)*(n-2))
+		for v := range r {
+			r[v] *= scale
+		}
+	}
+	return r, nil
+}
+
+// EigenvectorCentrality scores each vertex by the sum of the scores of its
+// neighbours, incoming neighbours for directed graphs, found by power
+// iteration. Scores are scaled to unit length. Returns an error wrapping
+// ErrNotConverged if opts.MaxIterations is reached first.
+func EigenvectorCentrality[T comparable, D comparable](g Graph[T, D], opts *CentralityOptions) (map[T]float64, error) {
+	o := opts.withDefaults()
+	vertices := g.Vertices()
+	n := len(vertices)
+	x := make(map[T]float64, n)
+	if n == 0 {
+		return x, nil
+	}
+	for _, v := range vertices {
+		x[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < o.MaxIterations; i++ {
+		// starting from x rather than zero shifts the spectrum so the
+		// iteration settles on bipartite graphs instead of oscillating
+		next := make(map[T]float64, n)
+		for v, s := range x {
+			next[v] = s
+		}
+		for _, u := range vertices {
+			for _, e := range g.VectorEdges(u) {
+				next[e.Other(u)] += x[u]
+			}
+		}
+
+		norm := 0.0
+		for _, s := range next {
+			norm += s * s
+		}
+		norm = math.Sqrt(norm)
+		if norm == 0 {
+			norm = 1
+		}
+		change := 0.0
+		for v := range next {
+			next[v] /= norm
+			change += math.Abs(next[v] - x[v])
+		}
+		x = next
+		if change < float64(n)*o.Tolerance {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: eigenvector centrality after %d iterations", ErrNotConverged, o.MaxIterations)
+}
+
+// PageRank is the stationary distribution of a random walk that follows a
+// random outgoing edge with probability opts.Damping and otherwise jumps to a
+// random vertex, as does a walk stuck at a vertex with no outgoing edges.
+// Undirected edges can be walked either way. Scores sum to 1. Returns an error
+// wrapping ErrNotConverged if opts.MaxIterations is reached first.
+func PageRank[T comparable, D comparable](g Graph[T, D], opts *CentralityOptions) (map[T]float64, error) {
+	o := opts.withDefaults()
+	vertices := g.Vertices()
+	n := float64(len(vertices))
+	x := make(map[T]float64, len(vertices))
+	if len(vertices) == 0 {
+		return x, nil
+	}
+	out := make(map[T][]Edge[T, D], len(vertices))
+	for _, v := range vertices {
+		x[v] = 1 / n
+		out[v] = g.VectorEdges(v)
+	}
+
+	for i := 0; i < o.MaxIterations; i++ {
+		dangling := 0.0
+		for _, v := range vertices {
+			if len(out[v]) == 0 {
+				dangling += x[v]
+			}
+		}
+
+		next := make(map[T]float64, len(vertices))
+		base := (1-o.Damping)/n + o.Damping*dangling/n
+		for _, v := range vertices {
+			next[v] += base
+			for _, e := range out[v] {
+				next[e.Other(v)] += o.Damping * x[v] / float64(len(out[v]))
+			}
+		}
+
+		change := 0.0
+		for v := range next {
+			change += math.Abs(next[v] - x[v])
+		}
+		x = next
+		if change < n*o.Tolerance {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: pagerank after %d iterations", ErrNotConverged, o.MaxIterations)
+}
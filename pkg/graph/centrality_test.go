@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+// star with centre 0 and leaves 1 to n
+func starGraph(n int) Graph[int, string] {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= n; i++ {
+		g.AddVertex(i)
+	}
+	for i := 1; i <= n; i++ {
+		g.AddEdge(0, i, "")
+	}
+	return g
+}
+
+func approx(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-4
+}
+
+func TestHopCentrality(t *testing.T) {
+	g := starGraph(4)
+	expected := map[string][2]float64{
+		"degree":    {1, 0.25},
+		"closeness": {1, 4.0 / 7},
+		"harmonic":  {1, 0.625},
+	}
+	measures := map[string]map[int]float64{
+		"degree":    DegreeCentrality(g),
+		"closeness": ClosenessCentrality(g),
+		"harmonic":  HarmonicCentrality(g),
+	}
+	for name, c := range measures {
+		if !approx(c[0], expected[name][0]) || !approx(c[3], expected[name][1]) {
+			t.Fatalf("%s: expected %v got %v", name, expected[name], c)
+		}
+	}
+
+	// a vertex that reaches nothing scores zero
+	g.AddVertex(5)
+	if c := ClosenessCentrality(g); c[5] != 0 || !approx(c[0], 16.0/20) {
+		t.Fatalf("unexpected closeness %v", c)
+	}
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	c := BetweennessCentrality(starGraph(4))
+	if !approx(c[0], 1) || c[1] != 0 {
+		t.Fatalf("expected the centre on every path got %v", c)
+	}
+
+	// directed path 0->1->2, only the pair 0,2 goes through 1
+	dg := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 2; i++ {
+		dg.AddVertex(i)
+	}
+	dg.AddEdge(0, 1, "")
+	dg.AddEdge(1, 2, "")
+	if c := BetweennessCentrality[int, string](dg); !approx(c[1], 0.5) || c[0] != 0 {
+		t.Fatalf("expected 0.5 for the middle got %v", c)
+	}
+}
+
+func TestWeightedBetweennessCentrality(t *testing.T) {
+	// square a-b-c-d-a with a cheap side, b to d splits evenly between a
+	// and c while a to c always goes through b
+	g := NewAdjacencyListGraph[string, int]()
+	for _, v := range []string{"a", "b", "c", "d"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "d", 5)
+	g.AddEdge("d", "a", 5)
+
+	c, err := WeightedBetweennessCentrality(g, intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := map[string]float64{"a": 1.0 / 6, "b": 1.0 / 3, "c": 1.0 / 6, "d": 0}
+	for v, e := range expected {
+		if !approx(c[v], e) {
+			t.Fatalf("expected %v got %v", expected, c)
+		}
+	}
+
+	g.AddEdge("b", "d", -1)
+	if _, err := WeightedBetweennessCentrality(g, intWeight); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight got %v", err)
+	}
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	c, err := EigenvectorCentrality(completeGraph(5), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for v, s := range c {
+		if !approx(s, 1/math.Sqrt(5)) {
+			t.Fatalf("expected equal scores got %v for %d", s, v)
+		}
+	}
+
+	// the star is bipartite, the centre holds half the weight
+	c, err = EigenvectorCentrality(starGraph(4), &CentralityOptions{Tolerance: 1e-9, MaxIterations: 1000})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !approx(c[0], math.Sqrt(0.5)) || !approx(c[1], math.Sqrt(0.125)) {
+		t.Fatalf("unexpected scores %v", c)
+	}
+
+	if _, err := EigenvectorCentrality(starGraph(4), &CentralityOptions{MaxIterations: 1}); !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("expected ErrNotConverged got %v", err)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	c, err := PageRank(starGraph(4), nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	total := 0.0
+	for v, s := range c {
+		total += s
+		if v != 0 && s >= c[0] {
+			t.Fatalf("expected the centre to rank highest got %v", c)
+		}
+	}
+	if !approx(total, 1) {
+		t.Fatalf("expected scores to sum to 1 got %v", total)
+	}
+
+	// 2 is a dangling vertex that only 1 links to
+	dg := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 2; i++ {
+		dg.AddVertex(i)
+	}
+	dg.AddEdge(0, 1, "")
+	dg.AddEdge(1, 0, "")
+	dg.AddEdge(1, 2, "")
+	c, err = PageRank[int, string](dg, &CentralityOptions{Damping: 0.5})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	total = c[0] + c[1] + c[2]
+	if !approx(total, 1) || c[1] <= c[0] || c[1] <= c[2] {
+		t.Fatalf("unexpected scores %v", c)
+	}
+
+	if _, err := PageRank(starGraph(4), &CentralityOptions{MaxIterations: 1}); !errors.Is(err, ErrNotConverged) {
+		t.Fatalf("expected ErrNotConverged got %v", err)
+	}
+}
+
+func TestIterativeCentralityEmpty(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	if c, err := EigenvectorCentrality(g, nil); err != nil || len(c) != 0 {
+		t.Fatalf("expected no scores got %v %v", c, err)
+	}
+	if c, err := PageRank(g, nil); err != nil || len(c) != 0 {
+		t.Fatalf("expected no scores got %v %v", c, err)
+	}
+}
@@ -0,0 +1,106 @@
+package graph
+
+import "mervynrussell/gocol/pkg/set"
+
+// MaximalCliques calls found with every maximal clique of g, a clique that no
+// other vertex can be added to. Returning false from found stops the search.
+// Cliques are produced one at a time by Bron-Kerbosch with pivoting, the outer
+// loop following a degeneracy ordering, so only the current search path is
+// ever held in memory. Directed graphs are searched as if undirected.
+func MaximalCliques[T comparable, D comparable](g Graph[T, D], found func(clique set.Set[T]) bool) {
+	neighbours := make(map[T]set.Set[T])
+	for _, v := range g.Vertices() {
+		neighbours[v] = set.New[T]()
+		for _, e := range incidentEdges(g, v) {
+			neighbours[v].Add(e.Other(v))
+		}
+	}
+
+	// each vertex only searches with the neighbours after it in the ordering,
+	// ones before it have already had every clique containing them reported
+	earlier := set.New[T]()
+	for _, v := range degeneracyOrder(neighbours) {
+		p := neighbours[v].Difference(earlier)
+		x := neighbours[v].Intersection(earlier)
+		if !bronKerbosch(set.NewFrom([]T{v}), p, x, neighbours, found) {
+			return
+		}
+		earlier.Add(v)
+	}
+}
+
+// MaximumClique returns a largest clique of g
+func MaximumClique[T comparable, D comparable](g Graph[T, D]) set.Set[T] {
+	largest := set.New[T]()
+	MaximalCliques(g, func(clique set.Set[T]) bool {
+		if clique.Len() > largest.Len() {
+			largest = clique
+		}
+		return true
+	})
+	return largest
+}
+
+// bronKerbosch reports every maximal clique extending r with vertices from p
+// and none from x, false once found asks to stop
+func bronKerbosch[T comparable](r set.Set[T], p set.Set[T], x set.Set[T], neighbours map[T]set.Set[T], found func(set.Set[T]) bool) bool {
+	if p.IsEmpty() {
+		if x.IsEmpty() {
+			return found(r)
+		}
+		return true
+	}
+
+	// any maximal clique contains the pivot or one of its non neighbours, so
+	// only those need branching on
+	var pivot T
+	most := -1
+	for _, u := range p.Union(x).All() {
+		if n := p.Intersection(neighbours[u]).Len(); n > most {
+			pivot, most = u, n
+		}
+	}
+
+	for _, v := range p.Difference(neighbours[pivot]).All() {
+		rv := r.Union(set.NewFrom([]T{v}))
+		if !bronKerbosch(rv, p.Intersection(neighbours[v]), x.Intersection(neighbours[v]), neighbours, found) {
+			return false
+		}
+		p.Remove(v)
+		x.Add(v)
+	}
+	return true
+}
+
+type degreeItem[T comparable] struct {
+	v      T
+	degree int
+}
+
+// degeneracyOrder repeatedly takes the vertex of least remaining degree
+func degeneracyOrder[T comparable](neighbours map[T]set.Set[T]) []T {
+	degree := make(map[T]int, len(neighbours))
+	pq := newPriorityQueue(func(a, b degreeItem[T]) bool { return a.degree < b.degree })
+	for v, n := range neighbours {
+		degree[v] = n.Len()
+		pq.push(degreeItem[T]{v, degree[v]})
+	}
+
+	order := make([]T, 0, len(neighbours))
+	removed := make(map[T]bool, len(neighbours))
+	for pq.Len() > 0 {
+		item := pq.pop()
+		if removed[item.v] || item.degree != degree[item.v] {
+			continue
+		}
+		removed[item.v] = true
+		order = append(order, item.v)
+		for _, w := range neighbours[item.v].All() {
+			if !removed[w] {
+				degree[w]--
+				pq.push(degreeItem[T]{w, degree[w]})
+			}
+		}
+	}
+	return order
+}
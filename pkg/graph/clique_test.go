@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"testing"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 7; i++ {
+		g.AddVertex(i)
+	}
+	// K4 on 0-3, triangle 3-4-5, edge 5-6 and 7 on its own
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 5}, {5, 3}, {5, 6}} {
+		g.AddEdge(e[0], e[1], "")
+	}
+
+	expected := []set.Set[int]{
+		set.NewFrom([]int{0, 1, 2, 3}),
+		set.NewFrom([]int{3, 4, 5}),
+		set.NewFrom([]int{5, 6}),
+		set.NewFrom([]int{7}),
+	}
+	cliques := make([]set.Set[int], 0)
+	MaximalCliques[int, string](g, func(clique set.Set[int]) bool {
+		cliques = append(cliques, clique)
+		return true
+	})
+	if len(cliques) != len(expected) {
+		t.Fatalf("expected %d cliques got %d", len(expected), len(cliques))
+	}
+	for _, e := range expected {
+		found := false
+		for _, c := range cliques {
+			found = found || c.Equals(e)
+		}
+		if !found {
+			t.Fatalf("expected clique %v", e.All())
+		}
+	}
+
+	count := 0
+	MaximalCliques[int, string](g, func(set.Set[int]) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Fatalf("expected search to stop after 2 got %d", count)
+	}
+
+	if largest := MaximumClique[int, string](g); !largest.Equals(expected[0]) {
+		t.Fatalf("expected %v got %v", expected[0].All(), largest.All())
+	}
+}
+
+func TestMaximalCliquesComplete(t *testing.T) {
+	g := completeGraph(12)
+	count := 0
+	MaximalCliques(g, func(clique set.Set[int]) bool {
+		count++
+		if clique.Len() != 12 {
+			t.Fatalf("expected the whole graph got %v", clique.All())
+		}
+		return true
+	})
+	if count != 1 {
+		t.Fatalf("expected 1 got %d", count)
+	}
+
+	if MaximumClique(NewAdjacencyListGraph[int, string]()).Len() != 0 {
+		t.Fatal("expected empty clique for empty graph")
+	}
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"fmt"
+	"mervynrussell/gocol/pkg/set"
+	"sort"
+)
+
+// ColouringStrategy picks the order ColourVertices colours vertices in
+type ColouringStrategy int
+
+const (
+	// Greedy colours vertices in the order Vertices returns them
+	Greedy ColouringStrategy = iota
+	// WelshPowell sorts vertices by decreasing degree and fills one colour
+	// at a time
+	WelshPowell
+	// DSatur always colours the vertex with the most distinctly coloured
+	// neighbours next, breaking ties by degree
+	DSatur
+)
+
+// Colouring maps every vertex to a colour numbered from zero
+type Colouring[T comparable] map[T]int
+
+// Colours return number of colours used
+func (c Colouring[T]) Colours() int {
+	n := 0
+	for _, colour := range c {
+		if colour >= n {
+			n = colour + 1
+		}
+	}
+	return n
+}
+
+// Classes returns the vertices of each colour, indexed by colour
+func (c Colouring[T]) Classes() []set.Set[T] {
+	classes := make([]set.Set[T], c.Colours())
+	for i := range classes {
+		classes[i] = set.New[T]()
+	}
+	for v, colour := range c {
+		classes[colour].Add(v)
+	}
+	return classes
+}
+
+// ColourVertices gives adjacent vertices different colours using strategy,
+// none of them guarantee the fewest colours. Directed graphs are coloured as
+// if undirected.
+func ColourVertices[T comparable, D comparable](g Graph[T, D], strategy ColouringStrategy) (Colouring[T], error) {
+	neighbours := make(map[T]set.Set[T])
+	for _, v := range g.Vertices() {
+		neighbours[v] = set.New[T]()
+		for _, e := range incidentEdges(g, v) {
+			neighbours[v].Add(e.Other(v))
+		}
+	}
+
+	switch strategy {
+	case Greedy:
+		return greedyColouring(g.Vertices(), neighbours), nil
+	case WelshPowell:
+		return welshPowellColouring(g.Vertices(), neighbours), nil
+	case DSatur:
+		return dsaturColouring(neighbours), nil
+	}
+	return nil, fmt.Errorf("%w: colouring strategy %d", ErrUnknownStrategy, strategy)
+}
+
+// smallestFreeColour returns the lowest colour not used by any coloured
+// neighbour of v
+func smallestFreeColour[T comparable](c Colouring[T], neighbours set.Set[T]) int {
+	used := make(map[int]bool)
+	for _, w := range neighbours.All() {
+		if colour, ok := c[w]; ok {
+			used[colour] = true
+		}
+	}
+	colour := 0
+	for used[colour] {
+		colour++
+	}
+	return colour
+}
+
+func greedyColouring[T comparable](order []T, neighbours map[T]set.Set[T]) Colouring[T] {
+	c := make(Colouring[T], len(order))
+	for _, v := range order {
+		c[v] = smallestFreeColour(c, neighbours[v])
+	}
+	return c
+}
+
+func welshPowellColouring[T comparable](vertices []T, neighbours map[T]set.Set[T]) Colouring[T] {
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return neighbours[vertices[i]].Len() > neighbours[vertices[j]].Len()
+	})
+
+	c := make(Colouring[T], len(vertices))
+	for colour := 0; len(c) < len(vertices); colour++ {
+		class := set.New[T]()
+		for _, v := range vertices {
+			if _, ok := c[v]; ok {
+				continue
+			}
+			if class.Intersection(neighbours[v]).IsEmpty() {
+				c[v] = colour
+				class.Add(v)
+			}
+		}
+	}
+	return c
+}
+
+func dsaturColouring[T comparable](neighbours map[T]set.Set[T]) Colouring[T] {
+	c := make(Colouring[T], len(neighbours))
+	saturation := make(map[T]map[int]bool, len(neighbours))
+	for v := range neighbours {
+		saturation[v] = make(map[int]bool)
+	}
+
+	for len(c) < len(neighbours) {
+		var next T
+		best, bestDegree := -1, -1
+		for v, vn := range neighbours {
+			if _, ok := c[v]; ok {
+				continue
+			}
+			if s := len(saturation[v]); s > best || (s == best && vn.Len() > bestDegree) {
+				next, best, bestDegree = v, s, vn.Len()
+			}
+		}
+
+		colour := smallestFreeColour(c, neighbours[next])
+		c[next] = colour
+		for _, w := range neighbours[next].All() {
+			saturation[w][colour] = true
+		}
+	}
+	return c
+}
+
+// ColourEdges gives edges that share an endpoint different colours, greedily
+// taking the lowest colour free at both ends. At most 2*maxDegree-1 colours
+// are used.
+func ColourEdges[T comparable, D comparable](g Graph[T, D]) map[Edge[T, D]]int {
+	c := make(map[Edge[T, D]]int)
+	used := make(map[T]map[int]bool)
+	for _, v := range g.Vertices() {
+		used[v] = make(map[int]bool)
+	}
+	for _, e := range g.Edges() {
+		colour := 0
+		for used[e.u][colour] || used[e.v][colour] {
+			colour++
+		}
+		c[e] = colour
+		used[e.u][colour] = true
+		used[e.v][colour] = true
+	}
+	return c
+}
+
+// ValidateColouring checks every vertex of g has a colour different from all
+// of its neighbours, the first problem found is returned as a VertexError or
+// EdgeError wrapping ErrImproperColour
+func ValidateColouring[T comparable, D comparable](g Graph[T, D], c map[T]int) error {
+	for _, v := range g.Vertices() {
+		if _, ok := c[v]; !ok {
+			return &VertexError[T]{Vertex: v, Err: ErrImproperColour}
+		}
+	}
+	for _, e := range g.Edges() {
+		if c[e.u] == c[e.v] {
+			return &EdgeError[T]{U: e.u, V: e.v, Err: ErrImproperColour}
+		}
+	}
+	return nil
+}
+
+// ValidateEdgeColouring checks every edge of g has a colour different from all
+// edges sharing an endpoint with it, the first problem found is returned as
+// an EdgeError wrapping ErrImproperColour
+func ValidateEdgeColouring[T comparable, D comparable](g Graph[T, D], c map[Edge[T, D]]int) error {
+	used := make(map[T]map[int]bool)
+	for _, v := range g.Vertices() {
+		used[v] = make(map[int]bool)
+	}
+	for _, e := range g.Edges() {
+		colour, ok := c[e]
+		if !ok || used[e.u][colour] || used[e.v][colour] {
+			return &EdgeError[T]{U: e.u, V: e.v, Err: ErrImproperColour}
+		}
+		used[e.u][colour] = true
+		used[e.v][colour] = true
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func completeGraph(n int) Graph[int, string] {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+		for j := 0; j < i; j++ {
+			g.AddEdge(j, i, "")
+		}
+	}
+	return g
+}
+
+func cycleGraph(n int) Graph[int, string] {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i < n; i++ {
+		g.AddVertex(i)
+	}
+	for i := 0; i < n; i++ {
+		g.AddEdge(i, (i+1)%n, "")
+	}
+	return g
+}
+
+func TestColourVertices(t *testing.T) {
+	for _, strategy := range []ColouringStrategy{Greedy, WelshPowell, DSatur} {
+		for _, g := range []Graph[int, string]{completeGraph(5), cycleGraph(6), cycleGraph(7), biconnectedTestGraph()} {
+			c, err := ColourVertices(g, strategy)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if err := ValidateColouring(g, c); err != nil {
+				t.Fatalf("strategy %d gave improper colouring %v", strategy, err)
+			}
+
+			total := 0
+			for colour, class := range c.Classes() {
+				total += class.Len()
+				for _, v := range class.All() {
+					if c[v] != colour {
+						t.Fatalf("%d is in class %d but coloured %d", v, colour, c[v])
+					}
+				}
+			}
+			if total != len(g.Vertices()) {
+				t.Fatal("expected classes to cover every vertex")
+			}
+		}
+	}
+
+	c, _ := ColourVertices(completeGraph(5), DSatur)
+	if c.Colours() != 5 {
+		t.Fatalf("expected 5 got %d", c.Colours())
+	}
+	// DSatur is exact for bipartite graphs and odd cycles
+	c, _ = ColourVertices(cycleGraph(6), DSatur)
+	if c.Colours() != 2 {
+		t.Fatalf("expected 2 got %d", c.Colours())
+	}
+	c, _ = ColourVertices(cycleGraph(7), DSatur)
+	if c.Colours() != 3 {
+		t.Fatalf("expected 3 got %d", c.Colours())
+	}
+
+	if _, err := ColourVertices(cycleGraph(3), ColouringStrategy(9)); !errors.Is(err, ErrUnknownStrategy) {
+		t.Fatalf("expected ErrUnknownStrategy got %v", err)
+	}
+}
+
+func TestValidateColouring(t *testing.T) {
+	g := cycleGraph(4)
+	err := ValidateColouring(g, map[int]int{0: 0, 1: 1, 2: 0, 3: 0})
+	var eErr *EdgeError[int]
+	if !errors.Is(err, ErrImproperColour) || !errors.As(err, &eErr) {
+		t.Fatalf("expected improper colour for edge 2:3 or 3:0 got %v", err)
+	}
+	err = ValidateColouring(g, map[int]int{0: 0, 1: 1, 2: 0})
+	var vErr *VertexError[int]
+	if !errors.As(err, &vErr) || vErr.Vertex != 3 {
+		t.Fatalf("expected missing colour for 3 got %v", err)
+	}
+}
+
+func TestColourEdges(t *testing.T) {
+	for _, g := range []Graph[int, string]{completeGraph(5), cycleGraph(7), biconnectedTestGraph()} {
+		c := ColourEdges(g)
+		if err := ValidateEdgeColouring(g, c); err != nil {
+			t.Fatalf("improper edge colouring %v", err)
+		}
+	}
+
+	g := cycleGraph(4)
+	c := ColourEdges(g)
+	for e := range c {
+		c[e] = 0
+	}
+	if err := ValidateEdgeColouring(g, c); !errors.Is(err, ErrImproperColour) {
+		t.Fatalf("expected ErrImproperColour got %v", err)
+	}
+}
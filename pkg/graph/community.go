@@ -0,0 +1,361 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"mervynrussell/gocol/pkg/set"
+	"sort"
+)
+
+// CommunityOptions for Louvain and LabelPropagation. Vertices are put in a
+// fixed order before any random choice is made so the same Seed always gives
+// the same partition, Less sets that order and when nil vertices are ordered
+// by their fmt representation. Resolution weights the null model in the
+// modularity Louvain optimises, above 1 favours smaller communities and zero
+// means 1. MaxIterations caps the rounds of label propagation, zero means
+// 100.
+type CommunityOptions[T comparable] struct {
+	Seed          int64
+	Less          func(a T, b T) bool
+	Resolution    float64
+	MaxIterations int
+}
+
+// Communities partitions a graph into densely connected Sets, Modularity
+// scores how much denser they are than a random graph with the same degrees
+type Communities[T comparable] struct {
+	*Components[T]
+	Modularity float64
+}
+
+// communityGraph is an undirected weighted graph over dense vertex indices.
+// Louvain collapses communities into single vertices so a vertex may carry
+// the weight of the edges inside it as a loop.
+type communityGraph struct {
+	adj    [][]communityEdge
+	loops  []float64
+	degree []float64
+	// total edge weight
+	m float64
+}
+
+type communityEdge struct {
+	to int
+	w  float64
+}
+
+// Louvain finds communities by greedily moving vertices to the neighbouring
+// community that most increases modularity, then collapsing each community
+// into one vertex and repeating until no move helps. Weights must not be
+// negative and the graph must be undirected.
+func Louvain[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W, opts *CommunityOptions[T]) (*Communities[T], error) {
+	o := communityDefaults(opts)
+	vertices, cg, err := newCommunityGraph(g, weight, o.Less)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(o.Seed))
+
+	membership := make([]int, len(vertices))
+	for i := range membership {
+		membership[i] = i
+	}
+	level := cg
+	for {
+		community, moved := level.moveVertices(rng, o.Resolution)
+		if !moved {
+			break
+		}
+		k := renumber(community)
+		for i, c := range membership {
+			membership[i] = community[c]
+		}
+		level = level.aggregate(community, k)
+	}
+	renumber(membership)
+	return newCommunities(vertices, membership, cg.modularity(membership, o.Resolution)), nil
+}
+
+// LabelPropagation finds communities by starting every vertex with its own
+// label and, in a random order each round, giving each vertex the label
+// carrying the most edge weight among its neighbours until every vertex
+// already has such a label. Ties are broken at random. Weights must not be
+// negative and the graph must be undirected. Returns an error wrapping
+// ErrNotConverged if opts.MaxIterations rounds pass with labels still
+// changing.
+func LabelPropagation[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W, opts *CommunityOptions[T]) (*Communities[T], error) {
+	o := communityDefaults(opts)
+	vertices, cg, err := newCommunityGraph(g, weight, o.Less)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(o.Seed))
+
+	labels := make([]int, len(vertices))
+	for i := range labels {
+		labels[i] = i
+	}
+	for round := 0; round < o.MaxIterations; round++ {
+		changed := false
+		for _, i := range rng.Perm(len(vertices)) {
+			weights, candidates := cg.neighbourWeights(i, labels)
+			best := 0.0
+			for _, l := range candidates {
+				if weights[l] > best {
+					best = weights[l]
+				}
+			}
+			if len(candidates) == 0 || weights[labels[i]] == best {
+				continue
+			}
+			ties := make([]int, 0)
+			for _, l := range candidates {
+				if weights[l] == best {
+					ties = append(ties, l)
+				}
+			}
+			labels[i] = ties[rng.Intn(len(ties))]
+			changed = true
+		}
+		if !changed {
+			renumber(labels)
+			return newCommunities(vertices, labels, cg.modularity(labels, 1)), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: label propagation after %d rounds", ErrNotConverged, o.MaxIterations)
+}
+
+// Modularity scores a partition of g, the fraction of edge weight inside the
+// sets less the fraction expected if edges were placed at random keeping each
+// vertex's weighted degree. Vertices in none of the sets count as communities
+// of their own.
+func Modularity[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W, sets []set.Set[T]) (float64, error) {
+	vertices, cg, err := newCommunityGraph(g, weight, nil)
+	if err != nil {
+		return 0, err
+	}
+	community := make([]int, len(vertices))
+	index := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+		community[i] = len(sets) + i
+	}
+	for c, s := range sets {
+		for _, v := range s.All() {
+			if i, ok := index[v]; ok {
+				community[i] = c
+			}
+		}
+	}
+	return cg.modularity(community, 1), nil
+}
+
+func communityDefaults[T comparable](opts *CommunityOptions[T]) CommunityOptions[T] {
+	var o CommunityOptions[T]
+	if opts != nil {
+		o = *opts
+	}
+	if o.Resolution <= 0 {
+		o.Resolution = 1
+	}
+	if o.MaxIterations <= 0 {
+		o.MaxIterations = 100
+	}
+	return o
+}
+
+// newCommunityGraph indexes the vertices of g in a deterministic order
+func newCommunityGraph[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W, less func(a T, b T) bool) ([]T, *communityGraph, error) {
+	if _, ok := g.(DirectedGraph[T, D]); ok {
+		return nil, nil, ErrDirected
+	}
+	vertices := g.Vertices()
+	if less == nil {
+		keys := make(map[T]string, len(vertices))
+		for _, v := range vertices {
+			keys[v] = fmt.Sprint(v)
+		}
+		less = func(a T, b T) bool { return keys[a] < keys[b] }
+	}
+	sort.SliceStable(vertices, func(i, j int) bool { return less(vertices[i], vertices[j]) })
+
+	index := make(map[T]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+	cg := &communityGraph{
+		adj:    make([][]communityEdge, len(vertices)),
+		loops:  make([]float64, len(vertices)),
+		degree: make([]float64, len(vertices)),
+	}
+	for i, v := range vertices {
+		for _, e := range g.VectorEdges(v) {
+			w := weight(e)
+			if w < 0 {
+				return nil, nil, &EdgeError[T]{U: e.U(), V: e.V(), Err: ErrNegativeWeight}
+			}
+			cg.adj[i] = append(cg.adj[i], communityEdge{index[e.Other(v)], float64(w)})
+		}
+		// the edge lists follow VectorEdges, sort them before summing so the
+		// order of floating point sums does not depend on the backend
+		edges := cg.adj[i]
+		sort.Slice(edges, func(i, j int) bool { return edges[i].to < edges[j].to })
+		for _, e := range edges {
+			cg.degree[i] += e.w
+		}
+		// each edge is seen from both ends
+		cg.m += cg.degree[i] / 2
+	}
+	return vertices, cg, nil
+}
+
+// neighbourWeights sums the edge weight from i to each community, candidates
+// lists the communities in the order first met
+func (cg *communityGraph) neighbourWeights(i int, community []int) (map[int]float64, []int) {
+	weights := make(map[int]float64)
+	candidates := make([]int, 0)
+	for _, e := range cg.adj[i] {
+		c := community[e.to]
+		if _, ok := weights[c]; !ok {
+			candidates = append(candidates, c)
+		}
+		weights[c] += e.w
+	}
+	return weights, candidates
+}
+
+// moveVertices is the local phase of Louvain, returning each vertex's
+// community and whether any vertex left the one it started in
+func (cg *communityGraph) moveVertices(rng *rand.Rand, resolution float64) ([]int, bool) {
+	n := len(cg.adj)
+	community := make([]int, n)
+	total := make([]float64, n)
+	for i := range community {
+		community[i] = i
+		total[i] = cg.degree[i]
+	}
+	if cg.m == 0 {
+		return community, false
+	}
+
+	// the gain of joining c, scaled by m, is the weight into c less the
+	// weight expected by chance
+	gain := func(i int, c int, w float64) float64 {
+		return w - resolution*total[c]*cg.degree[i]/(2*cg.m)
+	}
+
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for _, i := range rng.Perm(n) {
+			c := community[i]
+			weights, candidates := cg.neighbourWeights(i, community)
+			total[c] -= cg.degree[i]
+
+			best, bestGain := c, gain(i, c, weights[c])
+			for _, d := range candidates {
+				// the margin stops rounding error from swapping a vertex
+				// between two equally good communities forever
+				if g := gain(i, d, weights[d]); g > bestGain+1e-12 {
+					best, bestGain = d, g
+				}
+			}
+			total[best] += cg.degree[i]
+			if best != c {
+				community[i] = best
+				improved, moved = true, true
+			}
+		}
+	}
+	return community, moved
+}
+
+// aggregate collapses each of the k communities into a single vertex
+func (cg *communityGraph) aggregate(community []int, k int) *communityGraph {
+	r := &communityGraph{
+		adj:    make([][]communityEdge, k),
+		loops:  make([]float64, k),
+		degree: make([]float64, k),
+		m:      cg.m,
+	}
+	weights := make([]map[int]float64, k)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+	for i, edges := range cg.adj {
+		c := community[i]
+		r.loops[c] += cg.loops[i]
+		r.degree[c] += cg.degree[i]
+		for _, e := range edges {
+			if d := community[e.to]; d == c {
+				r.loops[c] += e.w / 2
+			} else {
+				weights[c][d] += e.w
+			}
+		}
+	}
+	for c, w := range weights {
+		for d, x := range w {
+			r.adj[c] = append(r.adj[c], communityEdge{d, x})
+		}
+		sort.Slice(r.adj[c], func(i, j int) bool { return r.adj[c][i].to < r.adj[c][j].to })
+	}
+	return r
+}
+
+func (cg *communityGraph) modularity(community []int, resolution float64) float64 {
+	if cg.m == 0 {
+		return 0
+	}
+	// ids are dense, Modularity's stay below the number of sets plus
+	// vertices, so slices summed in id order keep the score reproducible
+	k := 0
+	for _, c := range community {
+		if c >= k {
+			k = c + 1
+		}
+	}
+	inside := make([]float64, k)
+	total := make([]float64, k)
+	for i, edges := range cg.adj {
+		c := community[i]
+		total[c] += cg.degree[i]
+		inside[c] += cg.loops[i]
+		for _, e := range edges {
+			if community[e.to] == c {
+				inside[c] += e.w / 2
+			}
+		}
+	}
+	q := 0.0
+	for c, t := range total {
+		q += inside[c]/cg.m - resolution*(t/(2*cg.m))*(t/(2*cg.m))
+	}
+	return q
+}
+
+// renumber relabels community ids densely in order of first appearance,
+// returning how many there are
+func renumber(community []int) int {
+	ids := make(map[int]int)
+	for i, c := range community {
+		id, ok := ids[c]
+		if !ok {
+			id = len(ids)
+			ids[c] = id
+		}
+		community[i] = id
+	}
+	return len(ids)
+}
+
+func newCommunities[T comparable](vertices []T, community []int, modularity float64) *Communities[T] {
+	sets := make([]set.Set[T], 0)
+	for i, v := range vertices {
+		for community[i] >= len(sets) {
+			sets = append(sets, set.New[T]())
+		}
+		sets[community[i]].Add(v)
+	}
+	return &Communities[T]{Components: newComponents(sets), Modularity: modularity}
+}
@@ -0,0 +1,191 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"mervynrussell/gocol/pkg/set"
+	"testing"
+)
+
+// ring of n cliques of size k, clique edges weigh 10 and the edges joining
+// one clique to the next weigh 1
+func cliqueRingGraph(n int, k int) Graph[string, int] {
+	g := NewAdjacencyListGraph[string, int]()
+	name := func(c int, i int) string { return fmt.Sprintf("%d.%d", c, i) }
+	for c := 0; c < n; c++ {
+		for i := 0; i < k; i++ {
+			g.AddVertex(name(c, i))
+		}
+	}
+	for c := 0; c < n; c++ {
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				g.AddEdge(name(c, i), name(c, j), 10)
+			}
+		}
+		if n > 1 && (n > 2 || c == 0) {
+			g.AddEdge(name(c, k-1), name((c+1)%n, 0), 1)
+		}
+	}
+	return g
+}
+
+func checkCliqueCommunities(r *Communities[string], n int, k int, t *testing.T) {
+	if r.Len() != n {
+		t.Fatalf("expected %d communities got %d", n, r.Len())
+	}
+	for c := 0; c < n; c++ {
+		first, _ := r.ComponentOf(fmt.Sprintf("%d.0", c))
+		for i := 1; i < k; i++ {
+			if !first.Contains(fmt.Sprintf("%d.%d", c, i)) {
+				t.Fatalf("expected clique %d in one community got %v", c, first.All())
+			}
+		}
+	}
+}
+
+func TestLouvain(t *testing.T) {
+	g := cliqueRingGraph(2, 4)
+	r, err := Louvain(g, intWeight, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkCliqueCommunities(r, 2, 4, t)
+	// each half holds 60 of the 121 total weight and half the degree
+	if expected := 120.0/121 - 0.5; math.Abs(r.Modularity-expected) > 1e-9 {
+		t.Fatalf("expected modularity %v got %v", expected, r.Modularity)
+	}
+
+	for seed := int64(0); seed < 5; seed++ {
+		opts := &CommunityOptions[string]{Seed: seed}
+		r, _ := Louvain(cliqueRingGraph(8, 5), intWeight, opts)
+		checkCliqueCommunities(r, 8, 5, t)
+		again, _ := Louvain(cliqueRingGraph(8, 5), intWeight, opts)
+		if again.Modularity != r.Modularity {
+			t.Fatalf("expected seed %d to repeat got %v and %v", seed, r.Modularity, again.Modularity)
+		}
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	for seed := int64(0); seed < 5; seed++ {
+		r, err := LabelPropagation(cliqueRingGraph(6, 4), intWeight, &CommunityOptions[string]{Seed: seed})
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		checkCliqueCommunities(r, 6, 4, t)
+	}
+
+	g := NewAdjacencyListGraph[string, int]()
+	g.AddVertex("a")
+	r, err := LabelPropagation(g, intWeight, nil)
+	if err != nil || r.Len() != 1 || r.Modularity != 0 {
+		t.Fatalf("expected a single community got %v %v", r, err)
+	}
+}
+
+func TestCommunityErrors(t *testing.T) {
+	dg := NewDirectedAdjacencyListGraph[string, int]()
+	if _, err := Louvain[string, int](dg, intWeight, nil); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+
+	g := cliqueRingGraph(2, 3)
+	g.AddEdge("0.0", "1.1", -1)
+	if _, err := LabelPropagation(g, intWeight, nil); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight got %v", err)
+	}
+}
+
+func TestModularity(t *testing.T) {
+	g := cliqueRingGraph(2, 4)
+	whole := set.NewFrom(g.Vertices())
+	q, err := Modularity(g, intWeight, []set.Set[string]{whole})
+	if err != nil || math.Abs(q) > 1e-9 {
+		t.Fatalf("expected 0 for a single community got %v %v", q, err)
+	}
+
+	halves := []set.Set[string]{
+		set.NewFrom([]string{"0.0", "0.1", "0.2", "0.3"}),
+		set.NewFrom([]string{"1.0", "1.1", "1.2", "1.3"}),
+	}
+	q, _ = Modularity(g, intWeight, halves)
+	if expected := 120.0/121 - 0.5; math.Abs(q-expected) > 1e-9 {
+		t.Fatalf("expected %v got %v", expected, q)
+	}
+}
+
+func TestCommunityBackends(t *testing.T) {
+	// the backends list the edges of 0 in opposite orders, and
+	// 0.1+0.2+0.3 rounds differently to 0.3+0.2+0.1
+	weighted := func(g Graph[int, float64]) Graph[int, float64] {
+		for i := 3; i >= 0; i-- {
+			g.AddVertex(i)
+		}
+		g.AddEdge(0, 1, 0.1)
+		g.AddEdge(0, 2, 0.2)
+		g.AddEdge(0, 3, 0.3)
+		g.AddEdge(2, 3, 0.7)
+		return g
+	}
+	weight := func(e Edge[int, float64]) float64 { return e.Data() }
+
+	list, _ := Louvain(weighted(NewAdjacencyListGraph[int, float64]()), weight, nil)
+	matrix, _ := Louvain(weighted(NewAdjacencyMatrixGraph[int, float64]()), weight, nil)
+	if list.Modularity != matrix.Modularity {
+		t.Fatalf("expected the same modularity got %v and %v", list.Modularity, matrix.Modularity)
+	}
+	halves := []set.Set[int]{set.NewFrom([]int{0, 1}), set.NewFrom([]int{2, 3})}
+	ql, _ := Modularity(weighted(NewAdjacencyListGraph[int, float64]()), weight, halves)
+	qm, _ := Modularity(weighted(NewAdjacencyMatrixGraph[int, float64]()), weight, halves)
+	if ql != qm {
+		t.Fatalf("expected the same modularity got %v and %v", ql, qm)
+	}
+}
+
+func TestCommunityReproducible(t *testing.T) {
+	// cliques of different sizes and irrational looking weights so every
+	// term of the modularity sum differs and its order shows in the result
+	g := NewAdjacencyListGraph[int, float64]()
+	sizes := []int{3, 4, 5, 6, 7, 8}
+	start := 0
+	for c, k := range sizes {
+		for i := 0; i < k; i++ {
+			g.AddVertex(start + i)
+		}
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				g.AddEdge(start+i, start+j, 1+float64(c)/7+float64(i*j)/13)
+			}
+		}
+		if c > 0 {
+			g.AddEdge(start-1, start, 0.1/float64(c))
+		}
+		start += k
+	}
+	weight := func(e Edge[int, float64]) float64 { return e.Data() }
+	opts := &CommunityOptions[int]{Seed: 1}
+
+	first, err := Louvain(g, weight, opts)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if first.Len() != len(sizes) {
+		t.Fatalf("expected %d communities got %d", len(sizes), first.Len())
+	}
+	q, _ := Modularity(g, weight, first.Sets)
+	lp, _ := LabelPropagation(g, weight, opts)
+	for i := 0; i < 50; i++ {
+		r, _ := Louvain(g, weight, opts)
+		if r.Modularity != first.Modularity {
+			t.Fatalf("expected seed to repeat got %v and %v", first.Modularity, r.Modularity)
+		}
+		if again, _ := Modularity(g, weight, first.Sets); again != q {
+			t.Fatalf("expected modularity to repeat got %v and %v", q, again)
+		}
+		if again, _ := LabelPropagation(g, weight, opts); again.Modularity != lp.Modularity {
+			t.Fatalf("expected seed to repeat got %v and %v", lp.Modularity, again.Modularity)
+		}
+	}
+}
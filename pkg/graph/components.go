@@ -0,0 +1,47 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"mervynrussell/gocol/pkg/unionfind"
+)
+
+// Components partitions the vertices of a graph into Sets
+type Components[T comparable] struct {
+	Sets  []set.Set[T]
+	index map[T]int
+}
+
+func newComponents[T comparable](sets []set.Set[T]) *Components[T] {
+	c := &Components[T]{Sets: sets, index: make(map[T]int)}
+	for i, s := range sets {
+		for _, v := range s.All() {
+			c.index[v] = i
+		}
+	}
+	return c
+}
+
+// ComponentOf returns the set containing v
+func (c *Components[T]) ComponentOf(v T) (set.Set[T], bool) {
+	i, ok := c.index[v]
+	if !ok {
+		return nil, false
+	}
+	return c.Sets[i], true
+}
+
+// Len return number of components
+func (c *Components[T]) Len() int {
+	return len(c.Sets)
+}
+
+// ConnectedComponents partitions g into sets of vertices joined by a path,
+// edge direction is ignored so for directed graphs these are the weakly
+// connected components
+func ConnectedComponents[T comparable, D comparable](g Graph[T, D]) *Components[T] {
+	uf := unionfind.NewFrom(g.Vertices())
+	for _, e := range g.Edges() {
+		uf.Union(e.u, e.v)
+	}
+	return newComponents(uf.Sets())
+}
@@ -0,0 +1,40 @@
+package graph
+
+import "testing"
+
+func TestConnectedComponents(t *testing.T) {
+	connectedComponentsTest(NewAdjacencyListGraph[int, string](), t)
+	connectedComponentsTest(NewAdjacencyMatrixGraph[int, string](), t)
+	connectedComponentsTest(NewDirectedAdjacencyListGraph[int, string](), t)
+}
+
+func connectedComponentsTest(g Graph[int, string], t *testing.T) {
+	for i := 0; i <= 6; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(2, 1, "2:1")
+	g.AddEdge(3, 4, "3:4")
+	g.AddEdge(5, 4, "5:4")
+
+	c := ConnectedComponents(g)
+	if c.Len() != 3 {
+		t.Fatalf("expected 3 got %d", c.Len())
+	}
+
+	c0, ok := c.ComponentOf(0)
+	if !ok || c0.Len() != 3 || !c0.Contains(2) {
+		t.Fatalf("unexpected component of 0 %v", c0.All())
+	}
+	c5, _ := c.ComponentOf(5)
+	if c5.Len() != 3 || c5.Contains(0) {
+		t.Fatalf("unexpected component of 5 %v", c5.All())
+	}
+	c6, _ := c.ComponentOf(6)
+	if c6.Len() != 1 {
+		t.Fatalf("expected 6 on its own got %v", c6.All())
+	}
+	if _, ok := c.ComponentOf(7); ok {
+		t.Fatal("expected no component for unknown vertex")
+	}
+}
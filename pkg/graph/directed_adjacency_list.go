@@ -0,0 +1,184 @@
+package graph
+
+import "container/list"
+
+// Directed graph based on adjacency list implementation, each vertex keeps a
+// list of its outgoing and incoming edges.
+type directedAdjacencyListGraph[T comparable, D comparable] struct {
+	out   map[T]*list.List
+	in    map[T]*list.List
+	edges map[internalEdge[T]]D
+}
+
+func NewDirectedAdjacencyListGraph[T comparable, D comparable]() *directedAdjacencyListGraph[T, D] {
+	return &directedAdjacencyListGraph[T, D]{
+		out:   make(map[T]*list.List),
+		in:    make(map[T]*list.List),
+		edges: make(map[internalEdge[T]]D),
+	}
+}
+
+func (g *directedAdjacencyListGraph[T, D]) Vertices() []T {
+	return mapKeys(g.out)
+}
+
+func (g *directedAdjacencyListGraph[T, D]) AddEdge(u T, v T, d D) (*Edge[T, D], error) {
+	if !g.ContainsVertex(u) {
+		return nil, vertexNotFound(u)
+	}
+
+	if !g.ContainsVertex(v) {
+		return nil, vertexNotFound(v)
+	}
+
+	if u == v {
+		return nil, &EdgeError[T]{U: u, V: v, Err: ErrSelfLoop}
+	}
+
+	edge := newInternalEdge(u, v)
+	if _, ok := g.edges[edge]; !ok {
+		g.edges[edge] = d
+		g.out[u].PushBack(edge)
+		g.in[v].PushBack(edge)
+	}
+	r := NewEdge(u, v, g.edges[edge])
+	return &r, nil
+}
+
+func (g *directedAdjacencyListGraph[T, D]) Edges() []Edge[T, D] {
+	edges := make([]Edge[T, D], 0, len(g.edges))
+	for e, d := range g.edges {
+		edges = append(edges, NewEdge(e.u, e.v, d))
+	}
+	return edges
+}
+
+// VectorEdges returns the outgoing edges of v
+func (g *directedAdjacencyListGraph[T, D]) VectorEdges(v T) []Edge[T, D] {
+	return g.OutEdges(v)
+}
+
+func (g *directedAdjacencyListGraph[T, D]) OutEdges(v T) []Edge[T, D] {
+	return g.listEdges(g.out[v])
+}
+
+func (g *directedAdjacencyListGraph[T, D]) InEdges(v T) []Edge[T, D] {
+	return g.listEdges(g.in[v])
+}
+
+func (g *directedAdjacencyListGraph[T, D]) listEdges(l *list.List) []Edge[T, D] {
+	if l == nil {
+		return []Edge[T, D]{}
+	}
+	edges := make([]Edge[T, D], 0, l.Len())
+	for e := l.Front(); e != nil; e = e.Next() {
+		iEdge := e.Value.(internalEdge[T])
+		edges = append(edges, NewEdge(iEdge.u, iEdge.v, g.edges[iEdge]))
+	}
+	return edges
+}
+
+func (g *directedAdjacencyListGraph[T, D]) Successors(v T) []T {
+	r := make([]T, 0)
+	for _, e := range g.OutEdges(v) {
+		r = append(r, e.v)
+	}
+	return r
+}
+
+func (g *directedAdjacencyListGraph[T, D]) Predecessors(v T) []T {
+	r := make([]T, 0)
+	for _, e := range g.InEdges(v) {
+		r = append(r, e.u)
+	}
+	return r
+}
+
+func (g *directedAdjacencyListGraph[T, D]) OutDegree(v T) int {
+	if l, ok := g.out[v]; ok {
+		return l.Len()
+	}
+	return 0
+}
+
+func (g *directedAdjacencyListGraph[T, D]) InDegree(v T) int {
+	if l, ok := g.in[v]; ok {
+		return l.Len()
+	}
+	return 0
+}
+
+// Reverse returns a new graph with the direction of every edge flipped
+func (g *directedAdjacencyListGraph[T, D]) Reverse() DirectedGraph[T, D] {
+	r := NewDirectedAdjacencyListGraph[T, D]()
+	for v := range g.out {
+		r.AddVertex(v)
+	}
+	for e, d := range g.edges {
+		r.AddEdge(e.v, e.u, d)
+	}
+	return r
+}
+
+func (g *directedAdjacencyListGraph[T, D]) AddVertex(v T) error {
+	if g.ContainsVertex(v) {
+		return &VertexError[T]{Vertex: v, Err: ErrDuplicateVertex}
+	}
+	g.out[v] = list.New()
+	g.in[v] = list.New()
+	return nil
+}
+
+// RemoveVertex removes v along with all of its incoming and outgoing edges,
+// returning the removed edges
+func (g *directedAdjacencyListGraph[T, D]) RemoveVertex(v T) ([]Edge[T, D], error) {
+	if !g.ContainsVertex(v) {
+		return nil, vertexNotFound(v)
+	}
+	removed := g.OutEdges(v)
+	for _, e := range g.InEdges(v) {
+		if e.u != v {
+			removed = append(removed, e)
+		}
+	}
+	for _, e := range removed {
+		g.RemoveEdge(e)
+	}
+	delete(g.out, v)
+	delete(g.in, v)
+	return removed, nil
+}
+
+func (g *directedAdjacencyListGraph[T, D]) RemoveEdge(e Edge[T, D]) error {
+	if !g.ContainsVertex(e.u) {
+		return vertexNotFound(e.u)
+	}
+	if !g.ContainsVertex(e.v) {
+		return vertexNotFound(e.v)
+	}
+	iEdge := newInternalEdge(e.u, e.v)
+	if _, ok := g.edges[iEdge]; !ok {
+		return &EdgeError[T]{U: e.u, V: e.v, Err: ErrEdgeNotFound}
+	}
+
+	if element := elementFromList[internalEdge[T]](*g.out[e.u], iEdge); element != nil {
+		g.out[e.u].Remove(element)
+	}
+
+	if element := elementFromList[internalEdge[T]](*g.in[e.v], iEdge); element != nil {
+		g.in[e.v].Remove(element)
+	}
+
+	delete(g.edges, iEdge)
+	return nil
+}
+
+func (g *directedAdjacencyListGraph[T, D]) ContainsVertex(v T) bool {
+	_, ok := g.out[v]
+	return ok
+}
+
+func (g *directedAdjacencyListGraph[T, D]) ContainsEdge(e Edge[T, D]) bool {
+	_, ok := g.edges[newInternalEdge(e.u, e.v)]
+	return ok
+}
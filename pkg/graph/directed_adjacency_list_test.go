@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestDirectedEdges(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(0, 2, "0:2")
+	g.AddEdge(2, 1, "2:1")
+	g.AddEdge(1, 0, "1:0")
+
+	if !g.ContainsEdge(NewEdge(1, 0, "")) || !g.ContainsEdge(NewEdge(0, 1, "")) {
+		t.Fatal("expected both 0:1 and 1:0")
+	}
+	if g.ContainsEdge(NewEdge(1, 2, "")) {
+		t.Fatal("unexpected 1:2")
+	}
+
+	if g.OutDegree(0) != 2 || g.InDegree(0) != 1 {
+		t.Fatalf("expected out 2 in 1 got out %d in %d", g.OutDegree(0), g.InDegree(0))
+	}
+	if g.InDegree(1) != 2 || g.OutDegree(3) != 0 || g.InDegree(3) != 0 {
+		t.Fatal("unexpected degree")
+	}
+
+	succ := g.Successors(0)
+	sort.Ints(succ)
+	if len(succ) != 2 || succ[0] != 1 || succ[1] != 2 {
+		t.Fatalf("expected [1 2] got %v", succ)
+	}
+	pred := g.Predecessors(1)
+	sort.Ints(pred)
+	if len(pred) != 2 || pred[0] != 0 || pred[1] != 2 {
+		t.Fatalf("expected [0 2] got %v", pred)
+	}
+
+	if len(g.VectorEdges(2)) != 1 || g.VectorEdges(2)[0] != NewEdge(2, 1, "2:1") {
+		t.Fatal("expected vector edges to be out edges")
+	}
+}
+
+func TestDirectedReverse(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 2; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+
+	r := g.Reverse()
+	if len(r.Vertices()) != 3 || len(r.Edges()) != 2 {
+		t.Fatal("expected reverse to keep all vertices and edges")
+	}
+	if !r.ContainsEdge(NewEdge(1, 0, "")) || r.ContainsEdge(NewEdge(0, 1, "")) {
+		t.Fatal("expected 0:1 to be reversed")
+	}
+	if e := r.OutEdges(2); len(e) != 1 || e[0] != NewEdge(2, 1, "1:2") {
+		t.Fatalf("expected 2:1 with data 1:2 got %v", e)
+	}
+	if !g.ContainsEdge(NewEdge(0, 1, "")) {
+		t.Fatal("expected original graph to be unchanged")
+	}
+}
+
+func TestDirectedRemoveVertex(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 2; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+	g.AddEdge(2, 0, "2:0")
+
+	removed, err := g.RemoveVertex(1)
+	if err != nil || len(removed) != 2 {
+		t.Fatalf("expected 2 removed edges got %v %v", removed, err)
+	}
+	if len(g.Edges()) != 1 || !g.ContainsEdge(NewEdge(2, 0, "")) {
+		t.Fatalf("expected only 2:0 got %v", g.Edges())
+	}
+	if g.OutDegree(0) != 0 || g.InDegree(2) != 0 {
+		t.Fatal("expected incident edges removed from neighbours")
+	}
+}
@@ -0,0 +1,478 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// DOTOptions for WriteDOT, every field may be left unset. VertexID names each
+// vertex and defaults to its fmt representation, it must be unique. The label
+// functions set the label attribute, overriding any label returned by the
+// attribute functions.
+type DOTOptions[T comparable, D comparable] struct {
+	Name             string
+	VertexID         func(v T) string
+	VertexLabel      func(v T) string
+	EdgeLabel        func(e Edge[T, D]) string
+	VertexAttributes func(v T) map[string]string
+	EdgeAttributes   func(e Edge[T, D]) map[string]string
+	GraphAttributes  map[string]string
+}
+
+// WriteDOT writes g in the Graphviz DOT language, as a digraph when g is a
+// DirectedGraph. Vertices, edges and attributes are sorted by ID so the same
+// graph always gives the same output.
+func WriteDOT[T comparable, D comparable](w io.Writer, g Graph[T, D], opts *DOTOptions[T, D]) error {
+	var o DOTOptions[T, D]
+	if opts != nil {
+		o = *opts
+	}
+	if o.VertexID == nil {
+		o.VertexID = func(v T) string { return fmt.Sprint(v) }
+	}
+
+	kind, op := "graph", "--"
+	_, directed := g.(DirectedGraph[T, D])
+	if directed {
+		kind, op = "digraph", "->"
+	}
+
+	bw := bufio.NewWriter(w)
+	if o.Name != "" {
+		fmt.Fprintf(bw, "%s %s {\n", kind, dotQuote(o.Name))
+	} else {
+		fmt.Fprintf(bw, "%s {\n", kind)
+	}
+	for _, k := range sortedKeys(o.GraphAttributes) {
+		fmt.Fprintf(bw, "\t%s=%s;\n", dotKey(k), dotQuote(o.GraphAttributes[k]))
+	}
+
+	vertices, ids := sortedByID(g, o.VertexID)
+	for _, v := range vertices {
+		attrs := make(map[string]string)
+		if o.VertexAttributes != nil {
+			for k, a := range o.VertexAttributes(v) {
+				attrs[k] = a
+			}
+		}
+		if o.VertexLabel != nil {
+			attrs["label"] = o.VertexLabel(v)
+		}
+		fmt.Fprintf(bw, "\t%s%s;\n", dotQuote(ids[v]), dotAttributes(attrs))
+	}
+
+	for _, de := range edgesByID(g, ids, directed) {
+		attrs := make(map[string]string)
+		if o.EdgeAttributes != nil {
+			for k, a := range o.EdgeAttributes(de.e) {
+				attrs[k] = a
+			}
+		}
+		if o.EdgeLabel != nil {
+			attrs["label"] = o.EdgeLabel(de.e)
+		}
+		fmt.Fprintf(bw, "\t%s %s %s%s;\n", dotQuote(de.u), op, dotQuote(de.v), dotAttributes(attrs))
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// dotQuote escapes backslashes as well as quotes so an ID ending in a
+// backslash cannot escape the closing quote
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+// dotKey leaves attribute names that are plain identifiers unquoted
+func dotKey(s string) string {
+	for i, c := range s {
+		if !(c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || i > 0 && '0' <= c && c <= '9') {
+			return dotQuote(s)
+		}
+	}
+	if s == "" {
+		return dotQuote(s)
+	}
+	return s
+}
+
+func dotAttributes(attrs map[string]string) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(attrs))
+	for _, k := range sortedKeys(attrs) {
+		parts = append(parts, dotKey(k)+"="+dotQuote(attrs[k]))
+	}
+	return " [" + strings.Join(parts, ", ") + "]"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := mapKeys(m)
+	sort.Strings(keys)
+	return keys
+}
+
+// ReadDOT parses a graph written in the DOT language into a new adjacency list
+// graph, directed for a digraph. vertex converts each vertex ID and edge builds
+// the data of each edge from its attributes, including those set by edge
+// attribute statements. Vertices named only in edges are added too. Node and
+// graph attributes are read but not kept, subgraphs and ports are not
+// supported. Malformed input is reported as an error wrapping ErrSyntax.
+func ReadDOT[T comparable, D comparable](r io.Reader, vertex func(id string) (T, error), edge func(attrs map[string]string) (D, error)) (Graph[T, D], error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := dotTokenize(string(src))
+	if err != nil {
+		return nil, err
+	}
+	p := &dotParser[T, D]{tokens: tokens, vertex: vertex, edge: edge, ids: make(map[string]T)}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.g, nil
+}
+
+type dotToken struct {
+	text string
+	// quoted strings are never keywords or punctuation
+	quoted bool
+	line   int
+}
+
+func dotTokenize(src string) ([]dotToken, error) {
+	tokens := make([]dotToken, 0)
+	line := 1
+	isID := func(c byte) bool {
+		return c == '_' || c == '.' || c >= 0x80 ||
+			'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+	}
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '#' || strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("%w: dot line %d: unterminated comment", ErrSyntax, line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case strings.HasPrefix(src[i:], "--") || strings.HasPrefix(src[i:], "->"):
+			tokens = append(tokens, dotToken{text: src[i : i+2], line: line})
+			i += 2
+		case c == '"':
+			start := line
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, fmt.Errorf("%w: dot line %d: unterminated string", ErrSyntax, start)
+				}
+				if src[i] == '"' {
+					i++
+					break
+				}
+				if src[i] == '\\' && i+1 < len(src) {
+					switch src[i+1] {
+					case '"', '\\':
+						b.WriteByte(src[i+1])
+						i++
+						continue
+					case '\n':
+						// line continuation
+						line++
+						i++
+						continue
+					}
+				}
+				if src[i] == '\n' {
+					line++
+				}
+				b.WriteByte(src[i])
+			}
+			tokens = append(tokens, dotToken{text: b.String(), quoted: true, line: start})
+		case c == '<':
+			// HTML string, kept verbatim without the outer brackets
+			start, depth := i, 0
+			for ; i < len(src); i++ {
+				if src[i] == '<' {
+					depth++
+				} else if src[i] == '>' {
+					if depth--; depth == 0 {
+						break
+					}
+				} else if src[i] == '\n' {
+					line++
+				}
+			}
+			if i >= len(src) {
+				return nil, fmt.Errorf("%w: dot line %d: unterminated HTML string", ErrSyntax, line)
+			}
+			tokens = append(tokens, dotToken{text: src[start+1 : i], quoted: true, line: line})
+			i++
+		case isID(c) || c == '-' && i+1 < len(src) && ('0' <= src[i+1] && src[i+1] <= '9' || src[i+1] == '.'):
+			start := i
+			for i++; i < len(src) && isID(src[i]); i++ {
+			}
+			tokens = append(tokens, dotToken{text: src[start:i], line: line})
+		case strings.IndexByte("{}[];,=+:", c) >= 0:
+			tokens = append(tokens, dotToken{text: src[i : i+1], line: line})
+			i++
+		default:
+			return nil, fmt.Errorf("%w: dot line %d: unexpected %q", ErrSyntax, line, c)
+		}
+	}
+	return tokens, nil
+}
+
+type dotParser[T comparable, D comparable] struct {
+	tokens   []dotToken
+	pos      int
+	directed bool
+	g        Graph[T, D]
+	vertex   func(string) (T, error)
+	edge     func(map[string]string) (D, error)
+	ids      map[string]T
+	// defaults from edge attribute statements
+	edgeAttrs map[string]string
+}
+
+func (p *dotParser[T, D]) peek() *dotToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+// is reports whether the next token is the unquoted text s, keywords are case
+// insensitive
+func (p *dotParser[T, D]) is(s string) bool {
+	t := p.peek()
+	return t != nil && !t.quoted && strings.EqualFold(t.text, s)
+}
+
+func (p *dotParser[T, D]) errorf(format string, args ...any) error {
+	line := 0
+	if t := p.peek(); t != nil {
+		line = t.line
+	} else if len(p.tokens) > 0 {
+		line = p.tokens[len(p.tokens)-1].line
+	}
+	return fmt.Errorf("%w: dot line %d: %s", ErrSyntax, line, fmt.Sprintf(format, args...))
+}
+
+func (p *dotParser[T, D]) expect(s string) error {
+	if !p.is(s) {
+		return p.unexpected(fmt.Sprintf("%q", s))
+	}
+	p.pos++
+	return nil
+}
+
+func (p *dotParser[T, D]) unexpected(want string) error {
+	if t := p.peek(); t != nil {
+		return p.errorf("expected %s got %q", want, t.text)
+	}
+	return p.errorf("expected %s got end of input", want)
+}
+
+// id reads an ID, joining quoted strings concatenated with +
+func (p *dotParser[T, D]) id() (string, bool) {
+	t := p.peek()
+	if t == nil || !t.quoted && !dotIsID(t.text) {
+		return "", false
+	}
+	p.pos++
+	s := t.text
+	for t.quoted && p.is("+") && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].quoted {
+		t = &p.tokens[p.pos+1]
+		s += t.text
+		p.pos += 2
+	}
+	return s, true
+}
+
+func dotIsID(s string) bool {
+	return strings.IndexByte("{}[];,=+:", s[0]) < 0 && s != "--" && s != "->"
+}
+
+func (p *dotParser[T, D]) parse() error {
+	if p.is("strict") {
+		p.pos++
+	}
+	switch {
+	case p.is("graph"):
+		p.g = NewAdjacencyListGraph[T, D]()
+	case p.is("digraph"):
+		p.directed = true
+		p.g = NewDirectedAdjacencyListGraph[T, D]()
+	default:
+		return p.unexpected(`"graph" or "digraph"`)
+	}
+	p.pos++
+	if !p.is("{") {
+		if _, ok := p.id(); !ok {
+			return p.unexpected(`"{"`)
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.is("}") {
+		if p.peek() == nil {
+			return p.unexpected(`"}"`)
+		}
+		if err := p.statement(); err != nil {
+			return err
+		}
+		if p.is(";") {
+			p.pos++
+		}
+	}
+	p.pos++
+	if p.peek() != nil {
+		return p.errorf("unexpected %q after graph", p.peek().text)
+	}
+	return nil
+}
+
+func (p *dotParser[T, D]) statement() error {
+	switch {
+	case p.is("graph") || p.is("node"):
+		p.pos++
+		_, err := p.attributes()
+		return err
+	case p.is("edge"):
+		p.pos++
+		attrs, err := p.attributes()
+		if err != nil {
+			return err
+		}
+		if p.edgeAttrs == nil {
+			p.edgeAttrs = make(map[string]string)
+		}
+		for k, a := range attrs {
+			p.edgeAttrs[k] = a
+		}
+		return nil
+	case p.is("subgraph") || p.is("{"):
+		return p.errorf("subgraphs are not supported")
+	}
+
+	line := p.peek().line
+	first, ok := p.id()
+	if !ok {
+		return p.unexpected("a statement")
+	}
+	if p.is("=") {
+		// graph attribute
+		p.pos++
+		if _, ok := p.id(); !ok {
+			return p.unexpected("an ID")
+		}
+		return nil
+	}
+	if p.is(":") {
+		return p.errorf("ports are not supported")
+	}
+
+	chain := []string{first}
+	for p.is("--") || p.is("->") {
+		if p.is("->") != p.directed {
+			return p.errorf("edge operator %q does not match the graph type", p.peek().text)
+		}
+		p.pos++
+		next, ok := p.id()
+		if !ok {
+			return p.unexpected("an ID")
+		}
+		chain = append(chain, next)
+	}
+	attrs, err := p.attributes()
+	if err != nil {
+		return err
+	}
+
+	vertices := make([]T, len(chain))
+	for i, id := range chain {
+		if vertices[i], err = p.addVertex(id, line); err != nil {
+			return err
+		}
+	}
+	if len(chain) == 1 {
+		return nil
+	}
+
+	for k, a := range p.edgeAttrs {
+		if _, ok := attrs[k]; !ok {
+			attrs[k] = a
+		}
+	}
+	d, err := p.edge(attrs)
+	if err != nil {
+		return fmt.Errorf("graph: dot line %d: %w", line, err)
+	}
+	for i := 1; i < len(vertices); i++ {
+		if _, err := p.g.AddEdge(vertices[i-1], vertices[i], d); err != nil {
+			return fmt.Errorf("graph: dot line %d: %w", line, err)
+		}
+	}
+	return nil
+}
+
+// attributes reads any number of bracketed attribute lists
+func (p *dotParser[T, D]) attributes() (map[string]string, error) {
+	attrs := make(map[string]string)
+	for p.is("[") {
+		p.pos++
+		for !p.is("]") {
+			k, ok := p.id()
+			if !ok {
+				return nil, p.unexpected("an attribute")
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			a, ok := p.id()
+			if !ok {
+				return nil, p.unexpected("an attribute value")
+			}
+			attrs[k] = a
+			if p.is(",") || p.is(";") {
+				p.pos++
+			}
+		}
+		p.pos++
+	}
+	return attrs, nil
+}
+
+func (p *dotParser[T, D]) addVertex(id string, line int) (T, error) {
+	if v, ok := p.ids[id]; ok {
+		return v, nil
+	}
+	v, err := p.vertex(id)
+	if err != nil {
+		return v, fmt.Errorf("graph: dot line %d: %w", line, err)
+	}
+	p.ids[id] = v
+	if !p.g.ContainsVertex(v) {
+		p.g.AddVertex(v)
+	}
+	return v, nil
+}
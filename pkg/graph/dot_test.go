@@ -0,0 +1,162 @@
+package graph
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func dotLabel(attrs map[string]string) (string, error) {
+	return attrs["label"], nil
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 1; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(2, 1, "b")
+	g.AddEdge(1, 3, `say "hi"`)
+
+	var b strings.Builder
+	err := WriteDOT[int, string](&b, g, &DOTOptions[int, string]{
+		Name:             "g",
+		EdgeLabel:        func(e Edge[int, string]) string { return e.Data() },
+		VertexAttributes: func(v int) map[string]string { return map[string]string{"shape": "box"} },
+		GraphAttributes:  map[string]string{"rankdir": "LR"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := `graph "g" {
+	rankdir="LR";
+	"1" [shape="box"];
+	"2" [shape="box"];
+	"3" [shape="box"];
+	"1" -- "2" [label="b"];
+	"1" -- "3" [label="say \"hi\""];
+}
+`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestDOTRoundTrip(t *testing.T) {
+	for _, g := range []Graph[int, string]{NewAdjacencyListGraph[int, string](), NewDirectedAdjacencyListGraph[int, string]()} {
+		for i := 0; i < 5; i++ {
+			g.AddVertex(i)
+		}
+		g.AddEdge(0, 1, "0:1")
+		g.AddEdge(1, 2, "1:2")
+		g.AddEdge(3, 1, "3:1")
+
+		var b strings.Builder
+		opts := &DOTOptions[int, string]{EdgeLabel: func(e Edge[int, string]) string { return e.Data() }}
+		if err := WriteDOT(&b, g, opts); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		r, err := ReadDOT(strings.NewReader(b.String()), strconv.Atoi, dotLabel)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		_, directed := g.(DirectedGraph[int, string])
+		if _, ok := r.(DirectedGraph[int, string]); ok != directed {
+			t.Fatalf("expected directed %v got %T", directed, r)
+		}
+		if len(r.Vertices()) != 5 || len(r.Edges()) != 3 {
+			t.Fatalf("expected 5 vertices and 3 edges got %v", r.Edges())
+		}
+		for _, e := range g.Edges() {
+			if !r.ContainsEdge(e) {
+				t.Fatalf("expected edge %v after round trip", e)
+			}
+		}
+	}
+
+	// IDs and labels ending in or holding backslashes and quotes
+	g := NewDirectedAdjacencyListGraph[string, string]()
+	ids := []string{`trail\`, `say "hi"`, `a\"b`, `\\`}
+	for _, id := range ids {
+		g.AddVertex(id)
+	}
+	g.AddEdge(ids[0], ids[1], `label\`)
+	g.AddEdge(ids[2], ids[3], `"`)
+
+	var b strings.Builder
+	opts := &DOTOptions[string, string]{EdgeLabel: func(e Edge[string, string]) string { return e.Data() }}
+	if err := WriteDOT[string, string](&b, g, opts); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	r, err := ReadDOT(strings.NewReader(b.String()), func(id string) (string, error) { return id, nil }, dotLabel)
+	if err != nil {
+		t.Fatalf("unexpected error %v\n%s", err, b.String())
+	}
+	if len(r.Vertices()) != len(ids) {
+		t.Fatalf("expected vertices %q got %q", ids, r.Vertices())
+	}
+	for _, e := range g.Edges() {
+		if !r.ContainsEdge(e) || r.(DirectedGraph[string, string]).OutEdges(e.U())[0].Data() != e.Data() {
+			t.Fatalf("expected edge %v got %v", e, r.Edges())
+		}
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	src := `/* fixture */
+strict digraph "deps" {
+	rankdir = LR  // graph attribute
+	node [shape=box]
+	edge [label=default, colour="red"]
+	# preprocessor style comment
+	a -> b -> c [label="chain"];
+	"long " + "name" -> a
+	d
+	e -> <<b>x</b>>
+}`
+	g, err := ReadDOT(strings.NewReader(src), func(id string) (string, error) { return id, nil }, dotLabel)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(g.Vertices()) != 7 {
+		t.Fatalf("expected 7 vertices got %v", g.Vertices())
+	}
+	for _, e := range []Edge[string, string]{
+		NewEdge("a", "b", "chain"),
+		NewEdge("b", "c", "chain"),
+		NewEdge("long name", "a", "default"),
+		NewEdge("e", "<b>x</b>", "default"),
+	} {
+		if !g.ContainsEdge(e) {
+			t.Fatalf("expected edge %v got %v", e, g.Edges())
+		}
+	}
+	if g.(DirectedGraph[string, string]).InDegree("a") != 1 {
+		t.Fatal("expected edge into a")
+	}
+}
+
+func TestReadDOTErrors(t *testing.T) {
+	for _, src := range []string{
+		"graph { a -> b }",
+		"digraph { a -> }",
+		"graph { a [label=x }",
+		"graph { subgraph s { a } }",
+		`graph { "a }`,
+		"graph { 1 } 2",
+		"tree { }",
+	} {
+		if _, err := ReadDOT(strings.NewReader(src), strconv.Atoi, dotLabel); !errors.Is(err, ErrSyntax) {
+			t.Fatalf("expected ErrSyntax for %q got %v", src, err)
+		}
+	}
+
+	if _, err := ReadDOT(strings.NewReader("graph { 1 -- x }"), strconv.Atoi, dotLabel); !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected the converter error got %v", err)
+	}
+	if _, err := ReadDOT(strings.NewReader("graph { 1 -- 1 }"), strconv.Atoi, dotLabel); !errors.Is(err, ErrSelfLoop) {
+		t.Fatalf("expected ErrSelfLoop got %v", err)
+	}
+}
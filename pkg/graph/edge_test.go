@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestEdgeAccessors(t *testing.T) {
+	e := NewEdge(1, 2, "1:2")
+	if e.U() != 1 || e.V() != 2 || e.Data() != "1:2" {
+		t.Fatalf("unexpected accessors for %v", e)
+	}
+	if u, v := e.Endpoints(); u != 1 || v != 2 {
+		t.Fatalf("expected 1, 2 got %d, %d", u, v)
+	}
+	if e.Other(1) != 2 || e.Other(2) != 1 {
+		t.Fatal("unexpected other endpoint")
+	}
+	if r := e.Reversed(); r.U() != 2 || r.V() != 1 || r.Data() != "1:2" {
+		t.Fatalf("unexpected reversed %v", r)
+	}
+}
+
+func TestEdgeFormat(t *testing.T) {
+	e := NewEdge(1, 2, "a")
+	cases := map[string]string{
+		"%v":  "(1, 2, a)",
+		"%s":  "(1, 2, a)",
+		"%q":  `"(1, 2, a)"`,
+		"%+v": "{u:1 v:2 d:a}",
+		"%#v": `graph.Edge[int,string]{u:1, v:2, d:"a"}`,
+		"%d":  "%!d(graph.Edge=(1, 2, a))",
+	}
+	for format, expected := range cases {
+		if actual := fmt.Sprintf(format, e); actual != expected {
+			t.Fatalf("%s expected %s got %s", format, expected, actual)
+		}
+	}
+	if actual := fmt.Sprintf("%v", &e); actual != "(1, 2, a)" {
+		t.Fatalf("expected pointer to format as edge got %s", actual)
+	}
+}
+
+func TestEdgeJSON(t *testing.T) {
+	e := NewEdge(1, 2, "a")
+	b, err := json.Marshal(e)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if string(b) != `{"u":1,"v":2,"data":"a"}` {
+		t.Fatalf("unexpected json %s", b)
+	}
+
+	var decoded Edge[int, string]
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if decoded != e {
+		t.Fatalf("expected %v got %v", e, decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"u":"x"}`), &decoded); err == nil {
+		t.Fatal("expected error decoding mistyped endpoint")
+	}
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrVertexNotFound  = errors.New("graph: vertex not found")
+	ErrDuplicateVertex = errors.New("graph: duplicate vertex")
+	ErrEdgeNotFound    = errors.New("graph: edge not found")
+	ErrSelfLoop        = errors.New("graph: self loop")
+	ErrNegativeWeight  = errors.New("graph: negative edge weight")
+	ErrNoPath          = errors.New("graph: no path")
+	ErrNegativeCycle   = errors.New("graph: negative cycle")
+	ErrSearchLimit     = errors.New("graph: search limit reached")
+	ErrCycle           = errors.New("graph: cycle")
+	ErrDirected        = errors.New("graph: undirected graph required")
+	ErrSourceIsSink    = errors.New("graph: source and sink are the same vertex")
+	ErrNotBipartite    = errors.New("graph: not bipartite")
+	ErrNotEulerian     = errors.New("graph: not eulerian")
+	ErrImproperColour  = errors.New("graph: improper colouring")
+	ErrUnknownStrategy = errors.New("graph: unknown strategy")
+	ErrNotConverged    = errors.New("graph: did not converge")
+	ErrSyntax          = errors.New("graph: syntax error")
+	ErrAttributeType   = errors.New("graph: attribute value does not match its type")
+)
+
+// VertexError records the vertex an operation failed on, Err is one of the
+// package sentinel errors
+type VertexError[T comparable] struct {
+	Vertex T
+	Err    error
+}
+
+func (e *VertexError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", e.Err, e.Vertex)
+}
+
+func (e *VertexError[T]) Unwrap() error {
+	return e.Err
+}
+
+// EdgeError records the endpoints of the edge an operation failed on, Err is
+// one of the package sentinel errors
+type EdgeError[T comparable] struct {
+	U   T
+	V   T
+	Err error
+}
+
+func (e *EdgeError[T]) Error() string {
+	return fmt.Sprintf("%v: (%v, %v)", e.Err, e.U, e.V)
+}
+
+func (e *EdgeError[T]) Unwrap() error {
+	return e.Err
+}
+
+func vertexNotFound[T comparable](v T) error {
+	return &VertexError[T]{Vertex: v, Err: ErrVertexNotFound}
+}
+
+// CycleError carries a cycle found where none was allowed, the first and last
+// of Vertices are the same vertex and Edges[i] joins Vertices[i] and
+// Vertices[i+1]
+type CycleError[T comparable, D comparable] struct {
+	Vertices []T
+	Edges    []Edge[T, D]
+}
+
+func (e *CycleError[T, D]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCycle, e.Vertices)
+}
+
+func (e *CycleError[T, D]) Unwrap() error {
+	return ErrCycle
+}
+
+// OddCycleError carries a cycle of odd length, the witness that a graph is not
+// bipartite. It is laid out the same as CycleError.
+type OddCycleError[T comparable, D comparable] struct {
+	Vertices []T
+	Edges    []Edge[T, D]
+}
+
+func (e *OddCycleError[T, D]) Error() string {
+	return fmt.Sprintf("%v: odd cycle %v", ErrNotBipartite, e.Vertices)
+}
+
+func (e *OddCycleError[T, D]) Unwrap() error {
+	return ErrNotBipartite
+}
+
+// NegativeCycleError carries a cycle of negative total weight, the first and
+// last of Vertices are the same vertex and Edges[i] joins Vertices[i] and
+// Vertices[i+1]
+type NegativeCycleError[T comparable, D comparable] struct {
+	Vertices []T
+	Edges    []Edge[T, D]
+}
+
+func (e *NegativeCycleError[T, D]) Error() string {
+	return fmt.Sprintf("%v: %v", ErrNegativeCycle, e.Vertices)
+}
+
+func (e *NegativeCycleError[T, D]) Unwrap() error {
+	return ErrNegativeCycle
+}
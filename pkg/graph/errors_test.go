@@ -0,0 +1,61 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrors(t *testing.T) {
+	errorsTest(NewAdjacencyListGraph[int, string](), t)
+	errorsTest(NewAdjacencyMatrixGraph[int, string](), t)
+	errorsTest(NewDirectedAdjacencyListGraph[int, string](), t)
+}
+
+func errorsTest(g Graph[int, string], t *testing.T) {
+	g.AddVertex(0)
+	g.AddVertex(1)
+
+	err := g.AddVertex(0)
+	if !errors.Is(err, ErrDuplicateVertex) {
+		t.Fatalf("expected ErrDuplicateVertex got %v", err)
+	}
+	var vErr *VertexError[int]
+	if !errors.As(err, &vErr) || vErr.Vertex != 0 {
+		t.Fatalf("expected VertexError for 0 got %v", err)
+	}
+
+	_, err = g.AddEdge(0, 2, "0:2")
+	if !errors.Is(err, ErrVertexNotFound) || !errors.As(err, &vErr) || vErr.Vertex != 2 {
+		t.Fatalf("expected ErrVertexNotFound for 2 got %v", err)
+	}
+
+	_, err = g.AddEdge(1, 1, "1:1")
+	var eErr *EdgeError[int]
+	if !errors.Is(err, ErrSelfLoop) || !errors.As(err, &eErr) || eErr.U != 1 || eErr.V != 1 {
+		t.Fatalf("expected ErrSelfLoop for 1:1 got %v", err)
+	}
+
+	err = g.RemoveEdge(NewEdge(0, 1, ""))
+	if !errors.Is(err, ErrEdgeNotFound) {
+		t.Fatalf("expected ErrEdgeNotFound got %v", err)
+	}
+
+	err = g.RemoveEdge(NewEdge(0, 3, ""))
+	if !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+
+	g.AddEdge(0, 1, "0:1")
+	if err := g.RemoveEdge(NewEdge(0, 1, "")); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	_, err = g.RemoveVertex(3)
+	if !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+
+	if err.Error() != "graph: vertex not found: 3" {
+		t.Fatalf("unexpected message %q", err.Error())
+	}
+}
@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+)
+
+// EulerianCircuit returns a closed walk using every edge exactly once, built
+// with Hierholzer's algorithm. Edges are oriented in the direction of travel
+// so an undirected edge may come back reversed. When there is no such circuit
+// the error wraps ErrNotEulerian and explains which condition failed.
+func EulerianCircuit[T comparable, D comparable](g Graph[T, D]) ([]Edge[T, D], error) {
+	start, err := eulerianStart(g, true)
+	if err != nil {
+		return nil, err
+	}
+	return hierholzer(g, start)
+}
+
+// EulerianPath returns a walk using every edge exactly once, which may be a
+// circuit. Edges are oriented in the direction of travel. When there is no
+// such path the error wraps ErrNotEulerian and explains which condition
+// failed.
+func EulerianPath[T comparable, D comparable](g Graph[T, D]) ([]Edge[T, D], error) {
+	start, err := eulerianStart(g, false)
+	if err != nil {
+		return nil, err
+	}
+	return hierholzer(g, start)
+}
+
+// eulerianStart checks the degree conditions and picks where the walk has to
+// start, nil when the graph has no edges
+func eulerianStart[T comparable, D comparable](g Graph[T, D], circuit bool) (*T, error) {
+	var start *T
+	if dg, ok := g.(DirectedGraph[T, D]); ok {
+		var first, end *T
+		for _, v := range dg.Vertices() {
+			v := v
+			out, in := dg.OutDegree(v), dg.InDegree(v)
+			switch {
+			case out == in:
+				if first == nil && out > 0 {
+					first = &v
+				}
+			case !circuit && out == in+1 && start == nil:
+				start = &v
+			case !circuit && in == out+1 && end == nil:
+				end = &v
+			default:
+				return nil, fmt.Errorf("%w: vertex %v has in degree %d and out degree %d", ErrNotEulerian, v, in, out)
+			}
+		}
+		switch {
+		case start != nil && end == nil:
+			return nil, fmt.Errorf("%w: vertex %v has one more outgoing than incoming edge but no vertex has one more incoming", ErrNotEulerian, *start)
+		case start == nil && end != nil:
+			return nil, fmt.Errorf("%w: vertex %v has one more incoming than outgoing edge but no vertex has one more outgoing", ErrNotEulerian, *end)
+		case start != nil:
+			return start, nil
+		}
+		return first, nil
+	}
+
+	odd := make([]T, 0)
+	for _, v := range g.Vertices() {
+		v := v
+		degree := len(g.VectorEdges(v))
+		if degree%2 == 1 {
+			odd = append(odd, v)
+		} else if start == nil && degree > 0 {
+			start = &v
+		}
+	}
+	switch {
+	case len(odd) == 0:
+		return start, nil
+	case circuit:
+		return nil, fmt.Errorf("%w: vertices %v have odd degree", ErrNotEulerian, odd)
+	case len(odd) == 2:
+		return &odd[0], nil
+	}
+	return nil, fmt.Errorf("%w: %d vertices have odd degree, at most 2 are allowed", ErrNotEulerian, len(odd))
+}
+
+type eulerStep[T comparable, D comparable] struct {
+	v   T
+	via *Edge[T, D]
+}
+
+func hierholzer[T comparable, D comparable](g Graph[T, D], start *T) ([]Edge[T, D], error) {
+	walk := make([]Edge[T, D], 0)
+	if start == nil {
+		return walk, nil
+	}
+
+	incident := make(map[T][]Edge[T, D])
+	next := make(map[T]int)
+	used := make(map[Edge[T, D]]bool)
+	edges := func(v T) []Edge[T, D] {
+		if _, ok := incident[v]; !ok {
+			incident[v] = g.VectorEdges(v)
+		}
+		return incident[v]
+	}
+
+	stk := []eulerStep[T, D]{{v: *start}}
+	for len(stk) > 0 {
+		top := stk[len(stk)-1]
+		vEdges := edges(top.v)
+		for next[top.v] < len(vEdges) && used[vEdges[next[top.v]]] {
+			next[top.v]++
+		}
+		if next[top.v] < len(vEdges) {
+			e := vEdges[next[top.v]]
+			used[e] = true
+			if e.u != top.v {
+				e = e.Reversed()
+			}
+			stk = append(stk, eulerStep[T, D]{v: e.v, via: &e})
+			continue
+		}
+		stk = stk[:len(stk)-1]
+		if top.via != nil {
+			walk = append(walk, *top.via)
+		}
+	}
+
+	if total := len(g.Edges()); len(walk) < total {
+		return nil, fmt.Errorf("%w: edges are not all connected, the walk covers %d of %d", ErrNotEulerian, len(walk), total)
+	}
+	slices.Reverse(walk)
+	return walk, nil
+}
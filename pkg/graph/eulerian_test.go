@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func checkEulerianWalk(g Graph[int, string], walk []Edge[int, string], closed bool, t *testing.T) {
+	if len(walk) != len(g.Edges()) {
+		t.Fatalf("expected %d edges got %v", len(g.Edges()), walk)
+	}
+	_, directed := g.(DirectedGraph[int, string])
+	seen := make(map[[2]int]bool)
+	for i, e := range walk {
+		if !g.ContainsEdge(e) {
+			t.Fatalf("unexpected edge %v", e)
+		}
+		key := [2]int{e.U(), e.V()}
+		if !directed && key[0] > key[1] {
+			key[0], key[1] = key[1], key[0]
+		}
+		if seen[key] {
+			t.Fatalf("edge %v used twice", e)
+		}
+		seen[key] = true
+		if i > 0 && walk[i-1].V() != e.U() {
+			t.Fatalf("walk breaks between %v and %v", walk[i-1], e)
+		}
+	}
+	if closed && walk[0].U() != walk[len(walk)-1].V() {
+		t.Fatalf("expected closed walk got %v", walk)
+	}
+}
+
+func TestEulerianUndirected(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 5; i++ {
+		g.AddVertex(i)
+	}
+	// two triangles sharing vertex 0 and 5 on its own
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 4}, {4, 0}} {
+		g.AddEdge(e[0], e[1], "")
+	}
+
+	walk, err := EulerianCircuit[int, string](g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkEulerianWalk(g, walk, true, t)
+
+	g.AddEdge(1, 3, "")
+	_, err = EulerianCircuit[int, string](g)
+	if !errors.Is(err, ErrNotEulerian) {
+		t.Fatalf("expected ErrNotEulerian got %v", err)
+	}
+	walk, err = EulerianPath[int, string](g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkEulerianWalk(g, walk, false, t)
+	if s, e := walk[0].U(), walk[len(walk)-1].V(); !(s == 1 && e == 3 || s == 3 && e == 1) {
+		t.Fatalf("expected path between the odd vertices 1 and 3 got %v", walk)
+	}
+
+	g.AddEdge(2, 4, "")
+	if _, err = EulerianPath[int, string](g); !errors.Is(err, ErrNotEulerian) {
+		t.Fatalf("expected ErrNotEulerian got %v", err)
+	}
+
+	empty := NewAdjacencyListGraph[int, string]()
+	empty.AddVertex(0)
+	if walk, err := EulerianCircuit[int, string](empty); err != nil || len(walk) != 0 {
+		t.Fatalf("expected empty circuit got %v %v", walk, err)
+	}
+}
+
+func TestEulerianDisconnected(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 5; i++ {
+		g.AddVertex(i)
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}} {
+		g.AddEdge(e[0], e[1], "")
+	}
+	if _, err := EulerianCircuit[int, string](g); !errors.Is(err, ErrNotEulerian) {
+		t.Fatalf("expected ErrNotEulerian got %v", err)
+	}
+}
+
+func TestEulerianDirected(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 2}} {
+		g.AddEdge(e[0], e[1], "")
+	}
+	walk, err := EulerianCircuit[int, string](g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkEulerianWalk(g, walk, true, t)
+
+	g.AddEdge(0, 3, "")
+	if _, err := EulerianCircuit[int, string](g); !errors.Is(err, ErrNotEulerian) {
+		t.Fatalf("expected ErrNotEulerian got %v", err)
+	}
+	walk, err = EulerianPath[int, string](g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkEulerianWalk(g, walk, false, t)
+	if walk[0].U() != 0 || walk[len(walk)-1].V() != 3 {
+		t.Fatalf("expected path from 0 to 3 got %v", walk)
+	}
+
+	g.AddEdge(1, 3, "")
+	if _, err := EulerianPath[int, string](g); !errors.Is(err, ErrNotEulerian) {
+		t.Fatalf("expected ErrNotEulerian got %v", err)
+	}
+}
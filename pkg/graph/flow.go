@@ -0,0 +1,241 @@
+package graph
+
+import "mervynrussell/gocol/pkg/set"
+
+// Flow is a maximum flow from a source to a sink. EdgeFlow holds the flow on
+// every edge of the input graph and Residual the capacity left between each
+// pair of vertices, counting flow that could be pushed back. SourceSide and
+// SinkSide are a minimum cut, CutEdges are the saturated edges crossing it and
+// their capacities add up to Value.
+type Flow[T comparable, D comparable, W Weight] struct {
+	Value      W
+	EdgeFlow   map[Edge[T, D]]W
+	Residual   DirectedGraph[T, W]
+	SourceSide set.Set[T]
+	SinkSide   set.Set[T]
+	CutEdges   []Edge[T, D]
+}
+
+// flowNetwork is the residual network used by the flow algorithms, arcs are
+// stored in pairs so arc i^1 is the reverse of arc i
+type flowNetwork[T comparable, D comparable, W Weight] struct {
+	vertices []T
+	index    map[T]int
+	adj      [][]int
+	to       []int
+	residual []W
+	edges    []Edge[T, D]
+	source   int
+	sink     int
+}
+
+func newFlowNetwork[T comparable, D comparable, W Weight](g DirectedGraph[T, D], source T, sink T, capacity func(Edge[T, D]) W) (*flowNetwork[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	if !g.ContainsVertex(sink) {
+		return nil, vertexNotFound(sink)
+	}
+	if source == sink {
+		return nil, &EdgeError[T]{U: source, V: sink, Err: ErrSourceIsSink}
+	}
+
+	n := &flowNetwork[T, D, W]{vertices: g.Vertices(), index: make(map[T]int)}
+	n.adj = make([][]int, len(n.vertices))
+	for i, v := range n.vertices {
+		n.index[v] = i
+	}
+	n.source, n.sink = n.index[source], n.index[sink]
+
+	for _, e := range g.Edges() {
+		c := capacity(e)
+		if c < 0 {
+			return nil, &EdgeError[T]{U: e.u, V: e.v, Err: ErrNegativeWeight}
+		}
+		u, v := n.index[e.u], n.index[e.v]
+		n.adj[u] = append(n.adj[u], len(n.to))
+		n.to = append(n.to, v)
+		n.residual = append(n.residual, c)
+		n.adj[v] = append(n.adj[v], len(n.to))
+		n.to = append(n.to, u)
+		n.residual = append(n.residual, 0)
+		n.edges = append(n.edges, e)
+	}
+	return n, nil
+}
+
+// EdmondsKarp computes a maximum flow from source to sink by repeatedly
+// augmenting along the shortest residual path, O(VE^2). Capacities come from
+// capacity and must not be negative.
+func EdmondsKarp[T comparable, D comparable, W Weight](g DirectedGraph[T, D], source T, sink T, capacity func(Edge[T, D]) W) (*Flow[T, D, W], error) {
+	n, err := newFlowNetwork(g, source, sink, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	parentArc := make([]int, len(n.vertices))
+	for {
+		for i := range parentArc {
+			parentArc[i] = -1
+		}
+		queue := []int{n.source}
+		for len(queue) > 0 && parentArc[n.sink] == -1 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range n.adj[u] {
+				v := n.to[a]
+				if n.residual[a] > 0 && parentArc[v] == -1 && v != n.source {
+					parentArc[v] = a
+					queue = append(queue, v)
+				}
+			}
+		}
+		if parentArc[n.sink] == -1 {
+			break
+		}
+
+		bottleneck := n.residual[parentArc[n.sink]]
+		for v := n.sink; v != n.source; v = n.to[parentArc[v]^1] {
+			if r := n.residual[parentArc[v]]; r < bottleneck {
+				bottleneck = r
+			}
+		}
+		for v := n.sink; v != n.source; v = n.to[parentArc[v]^1] {
+			n.residual[parentArc[v]] -= bottleneck
+			n.residual[parentArc[v]^1] += bottleneck
+		}
+	}
+	return n.result(), nil
+}
+
+// Dinic computes a maximum flow from source to sink by sending blocking flows
+// along a BFS level graph, O(V^2E) and usually much faster than EdmondsKarp.
+// Capacities come from capacity and must not be negative.
+func Dinic[T comparable, D comparable, W Weight](g DirectedGraph[T, D], source T, sink T, capacity func(Edge[T, D]) W) (*Flow[T, D, W], error) {
+	n, err := newFlowNetwork(g, source, sink, capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	// nothing more than the total capacity out of the source can ever be pushed
+	var limit W
+	for _, a := range n.adj[n.source] {
+		limit += n.residual[a]
+	}
+
+	level := make([]int, len(n.vertices))
+	next := make([]int, len(n.vertices))
+	var push func(u int, pushed W) W
+	push = func(u int, pushed W) W {
+		if u == n.sink {
+			return pushed
+		}
+		for ; next[u] < len(n.adj[u]); next[u]++ {
+			a := n.adj[u][next[u]]
+			v := n.to[a]
+			if n.residual[a] <= 0 || level[v] != level[u]+1 {
+				continue
+			}
+			send := pushed
+			if n.residual[a] < send {
+				send = n.residual[a]
+			}
+			if sent := push(v, send); sent > 0 {
+				n.residual[a] -= sent
+				n.residual[a^1] += sent
+				return sent
+			}
+		}
+		return 0
+	}
+
+	for {
+		for i := range level {
+			level[i] = -1
+		}
+		level[n.source] = 0
+		queue := []int{n.source}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range n.adj[u] {
+				if v := n.to[a]; n.residual[a] > 0 && level[v] == -1 {
+					level[v] = level[u] + 1
+					queue = append(queue, v)
+				}
+			}
+		}
+		if level[n.sink] == -1 {
+			break
+		}
+
+		for i := range next {
+			next[i] = 0
+		}
+		for push(n.source, limit) > 0 {
+		}
+	}
+	return n.result(), nil
+}
+
+func (n *flowNetwork[T, D, W]) result() *Flow[T, D, W] {
+	f := &Flow[T, D, W]{
+		EdgeFlow:   make(map[Edge[T, D]]W, len(n.edges)),
+		Residual:   NewDirectedAdjacencyListGraph[T, W](),
+		SourceSide: set.New[T](),
+		SinkSide:   set.New[T](),
+		CutEdges:   make([]Edge[T, D], 0),
+	}
+
+	residual := make(map[internalEdge[T]]W)
+	for i, e := range n.edges {
+		// the reverse arc's residual capacity is the flow sent forward
+		flow := n.residual[2*i+1]
+		f.EdgeFlow[e] = flow
+		if e.u == n.vertices[n.source] {
+			f.Value += flow
+		}
+		if e.v == n.vertices[n.source] {
+			f.Value -= flow
+		}
+		for _, a := range []int{2 * i, 2*i + 1} {
+			if n.residual[a] > 0 {
+				u, v := n.vertices[n.to[a^1]], n.vertices[n.to[a]]
+				residual[newInternalEdge(u, v)] += n.residual[a]
+			}
+		}
+	}
+	for _, v := range n.vertices {
+		f.Residual.AddVertex(v)
+	}
+	for e, r := range residual {
+		f.Residual.AddEdge(e.u, e.v, r)
+	}
+
+	reached := make([]bool, len(n.vertices))
+	reached[n.source] = true
+	queue := []int{n.source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, a := range n.adj[u] {
+			if v := n.to[a]; n.residual[a] > 0 && !reached[v] {
+				reached[v] = true
+				queue = append(queue, v)
+			}
+		}
+	}
+	for i, v := range n.vertices {
+		if reached[i] {
+			f.SourceSide.Add(v)
+		} else {
+			f.SinkSide.Add(v)
+		}
+	}
+	for _, e := range n.edges {
+		if reached[n.index[e.u]] && !reached[n.index[e.v]] {
+			f.CutEdges = append(f.CutEdges, e)
+		}
+	}
+	return f
+}
@@ -0,0 +1,117 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+type maxFlowFunc func(DirectedGraph[string, int], string, string, func(Edge[string, int]) int) (*Flow[string, int, int], error)
+
+func flowTestGraph() DirectedGraph[string, int] {
+	g := NewDirectedAdjacencyListGraph[string, int]()
+	for _, v := range []string{"s", "a", "b", "c", "d", "t", "x"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("s", "a", 16)
+	g.AddEdge("s", "b", 13)
+	g.AddEdge("a", "c", 12)
+	g.AddEdge("b", "a", 4)
+	g.AddEdge("b", "d", 14)
+	g.AddEdge("c", "b", 9)
+	g.AddEdge("c", "t", 20)
+	g.AddEdge("d", "c", 7)
+	g.AddEdge("d", "t", 4)
+	g.AddEdge("a", "s", 3)
+	return g
+}
+
+func TestMaxFlow(t *testing.T) {
+	for _, maxFlow := range []maxFlowFunc{EdmondsKarp[string, int, int], Dinic[string, int, int]} {
+		maxFlowTest(maxFlow, t)
+	}
+}
+
+func maxFlowTest(maxFlow maxFlowFunc, t *testing.T) {
+	g := flowTestGraph()
+	f, err := maxFlow(g, "s", "t", intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if f.Value != 23 {
+		t.Fatalf("expected 23 got %d", f.Value)
+	}
+
+	balance := make(map[string]int)
+	for _, e := range g.Edges() {
+		flow := f.EdgeFlow[e]
+		if flow < 0 || flow > e.Data() {
+			t.Fatalf("flow %d on %v breaks capacity", flow, e)
+		}
+		balance[e.U()] -= flow
+		balance[e.V()] += flow
+	}
+	for v, b := range balance {
+		if v != "s" && v != "t" && b != 0 {
+			t.Fatalf("flow not conserved at %s", v)
+		}
+	}
+	if balance["t"] != 23 {
+		t.Fatalf("expected 23 into t got %d", balance["t"])
+	}
+
+	cut := 0
+	for _, e := range f.CutEdges {
+		if !f.SourceSide.Contains(e.U()) || !f.SinkSide.Contains(e.V()) || f.EdgeFlow[e] != e.Data() {
+			t.Fatalf("unexpected cut edge %v", e)
+		}
+		cut += e.Data()
+	}
+	if cut != 23 {
+		t.Fatalf("expected cut capacity 23 got %d", cut)
+	}
+	if !f.SourceSide.Contains("s") || !f.SinkSide.Contains("t") || f.SourceSide.Len()+f.SinkSide.Len() != 7 {
+		t.Fatal("expected cut to partition the vertices")
+	}
+
+	// whatever c -> t does not carry is left as residual capacity
+	r := f.Residual.OutEdges("c")
+	residual := make(map[string]int)
+	for _, e := range r {
+		residual[e.V()] = e.Data()
+	}
+	if residual["t"] != 20-f.EdgeFlow[NewEdge("c", "t", 20)] {
+		t.Fatalf("unexpected residual from c %v", r)
+	}
+	if back := f.Residual.InEdges("c"); len(back) == 0 {
+		t.Fatal("expected residual edges into c")
+	}
+}
+
+func TestMaxFlowErrors(t *testing.T) {
+	g := flowTestGraph()
+	if _, err := Dinic(g, "s", "s", intWeight); !errors.Is(err, ErrSourceIsSink) {
+		t.Fatalf("expected ErrSourceIsSink got %v", err)
+	}
+	if _, err := EdmondsKarp(g, "s", "z", intWeight); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+	g.AddEdge("x", "t", -1)
+	if _, err := Dinic(g, "s", "t", intWeight); !errors.Is(err, ErrNegativeWeight) {
+		t.Fatalf("expected ErrNegativeWeight got %v", err)
+	}
+
+	f, _ := EdmondsKarp(flowTestGraph(), "x", "t", intWeight)
+	if f.Value != 0 || f.SourceSide.Len() != 1 || len(f.CutEdges) != 0 {
+		t.Fatalf("expected no flow from isolated source got %d", f.Value)
+	}
+}
+
+func TestMaxFlowFloat(t *testing.T) {
+	g := flowTestGraph()
+	half := func(e Edge[string, int]) float64 { return float64(e.Data()) / 2 }
+	ek, _ := EdmondsKarp(g, "s", "t", half)
+	dinic, _ := Dinic(g, "s", "t", half)
+	if ek.Value != 11.5 || dinic.Value != 11.5 {
+		t.Fatalf("expected 11.5 got %v and %v", ek.Value, dinic.Value)
+	}
+}
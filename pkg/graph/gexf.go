@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const gexfNamespace = "http://gexf.net/1.3"
+
+var gexfTypes = map[AttributeType]string{
+	StringAttribute: "string",
+	BoolAttribute:   "boolean",
+	IntAttribute:    "integer",
+	LongAttribute:   "long",
+	FloatAttribute:  "float",
+	DoubleAttribute: "double",
+}
+
+// WriteGEXF writes g as a static GEXF 1.3 graph, declaring the codec's keys
+// as node and edge attributes and setting defaultedgetype by whether g is a
+// DirectedGraph. Vertices and edges are sorted by ID so the same graph always
+// gives the same output. codec may be nil to write the structure alone.
+func WriteGEXF[T comparable, D comparable](w io.Writer, g Graph[T, D], codec *XMLCodec[T, D]) error {
+	var c XMLCodec[T, D]
+	if codec != nil {
+		c = *codec
+	}
+	edgeType := "undirected"
+	_, directed := g.(DirectedGraph[T, D])
+	if directed {
+		edgeType = "directed"
+	}
+
+	x := newXMLWriter(w)
+	x.start("gexf", "xmlns", gexfNamespace, "version", "1.3")
+	x.start("graph", "defaultedgetype", edgeType, "mode", "static")
+	writeKeys := func(class string, keys []XMLKey) error {
+		if len(keys) == 0 {
+			return nil
+		}
+		x.start("attributes", "class", class)
+		for i, k := range keys {
+			x.start("attribute", "id", strconv.Itoa(i), "title", k.Name, "type", gexfTypes[k.Type])
+			if k.Default != nil {
+				s, err := formatAttribute(k, k.Default)
+				if err != nil {
+					return err
+				}
+				x.element("default", s)
+			}
+			x.end("attribute")
+		}
+		x.end("attributes")
+		return nil
+	}
+	if err := writeKeys("node", c.VertexKeys); err != nil {
+		return err
+	}
+	if err := writeKeys("edge", c.EdgeKeys); err != nil {
+		return err
+	}
+
+	// attvalues is only written when there is at least one value
+	writeValues := func(keys []XMLKey, attrs map[string]any) error {
+		opened := false
+		err := xmlValues(keys, attrs, func(i int, s string) {
+			if !opened {
+				x.start("attvalues")
+				opened = true
+			}
+			x.start("attvalue", "for", strconv.Itoa(i), "value", s)
+			x.end("attvalue")
+		})
+		if opened {
+			x.end("attvalues")
+		}
+		return err
+	}
+
+	x.start("nodes")
+	vertices, ids := sortedByID(g, c.vertexID())
+	for _, v := range vertices {
+		x.start("node", "id", ids[v])
+		if c.VertexAttributes != nil {
+			if err := writeValues(c.VertexKeys, c.VertexAttributes(v)); err != nil {
+				return err
+			}
+		}
+		x.end("node")
+	}
+	x.end("nodes")
+
+	x.start("edges")
+	for i, ie := range edgesByID(g, ids, directed) {
+		x.start("edge", "id", strconv.Itoa(i), "source", ie.u, "target", ie.v)
+		if c.EdgeAttributes != nil {
+			if err := writeValues(c.EdgeKeys, c.EdgeAttributes(ie.e)); err != nil {
+				return err
+			}
+		}
+		x.end("edge")
+	}
+	x.end("edges")
+	x.end("graph")
+	x.end("gexf")
+	return x.close()
+}
+
+// ReadGEXF reads a GEXF document into a new adjacency list graph, directed
+// when defaultedgetype is directed. The document is decoded a token at a time
+// so only the graph being built is held in memory. Attribute values are
+// converted to the Go type of their declared type and declared defaults fill
+// in values a vertex or edge leaves out. A node label and an edge weight are
+// passed on as the label string and weight float64 attributes unless an
+// attribute of that name is declared. Dynamic attributes, hierarchies and
+// visualisation data are not supported. Malformed documents are reported as an
+// error wrapping ErrSyntax.
+func ReadGEXF[T comparable, D comparable](r io.Reader, codec *XMLCodec[T, D]) (Graph[T, D], error) {
+	dec := xml.NewDecoder(r)
+	fail := func(err error) (Graph[T, D], error) {
+		line, _ := dec.InputPos()
+		var syntax *xml.SyntaxError
+		if errors.As(err, &syntax) {
+			return nil, fmt.Errorf("%w: gexf: %v", ErrSyntax, err)
+		}
+		return nil, fmt.Errorf("graph: gexf line %d: %w", line, err)
+	}
+
+	// attribute ids are only unique within their class
+	keys := map[string]map[string]XMLKey{"node": {}, "edge": {}}
+	var vertexKeys, edgeKeys []XMLKey
+	var b *xmlBuilder[T, D]
+	var class string
+	var current *xml.StartElement
+	var attrs map[string]any
+	done := false
+
+	for !done {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "graph":
+				if b != nil {
+					return fail(fmt.Errorf("%w: nested graphs are not supported", ErrSyntax))
+				}
+				if b, err = newXMLBuilder(codec, xmlAttr(t, "defaultedgetype") == "directed"); err != nil {
+					return nil, err
+				}
+			case "attributes":
+				class = xmlAttr(t, "class")
+				if xmlAttr(t, "mode") == "dynamic" {
+					return fail(fmt.Errorf("%w: dynamic attributes are not supported", ErrSyntax))
+				}
+			case "attribute":
+				k := XMLKey{Name: xmlAttr(t, "title"), Type: attributeType(gexfTypes, xmlAttr(t, "type"))}
+				if err := readXMLKeyDefault(dec, &k); err != nil {
+					return fail(err)
+				}
+				if _, ok := keys[class]; !ok {
+					continue
+				}
+				keys[class][xmlAttr(t, "id")] = k
+				if class == "node" {
+					vertexKeys = append(vertexKeys, k)
+				} else {
+					edgeKeys = append(edgeKeys, k)
+				}
+			case "node", "edge":
+				if b == nil || current != nil {
+					return fail(fmt.Errorf("%w: unexpected %s", ErrSyntax, t.Name.Local))
+				}
+				current, attrs = &t, make(map[string]any)
+				if err := gexfBuiltin(t, attrs, keys[t.Name.Local]); err != nil {
+					return fail(err)
+				}
+			case "attvalue":
+				if current == nil {
+					return fail(fmt.Errorf("%w: attvalue outside a node or edge", ErrSyntax))
+				}
+				k, ok := keys[current.Name.Local][xmlAttr(t, "for")]
+				if !ok {
+					return fail(fmt.Errorf("%w: undeclared attribute %q", ErrSyntax, xmlAttr(t, "for")))
+				}
+				if attrs[k.Name], err = parseAttribute(k, xmlAttr(t, "value")); err != nil {
+					return fail(err)
+				}
+			}
+
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "node":
+				if err := b.vertex(xmlAttr(*current, "id"), attrs, vertexKeys); err != nil {
+					return fail(err)
+				}
+				current = nil
+			case "edge":
+				if err := b.edge(xmlAttr(*current, "source"), xmlAttr(*current, "target"), attrs, edgeKeys); err != nil {
+					return fail(err)
+				}
+				current = nil
+			case "graph":
+				if err := b.finish(); err != nil {
+					return fail(err)
+				}
+				done = true
+			}
+		}
+	}
+	if !done {
+		return nil, fmt.Errorf("%w: gexf: no complete graph element", ErrSyntax)
+	}
+	return b.g, nil
+}
+
+// gexfBuiltin copies the label of a node and the weight of an edge into
+// attrs, unless an attribute of the same name is declared
+func gexfBuiltin(el xml.StartElement, attrs map[string]any, keys map[string]XMLKey) error {
+	declared := func(name string) bool {
+		for _, k := range keys {
+			if k.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, a := range el.Attr {
+		switch {
+		case el.Name.Local == "node" && a.Name.Local == "label" && !declared("label"):
+			attrs["label"] = a.Value
+		case el.Name.Local == "edge" && a.Name.Local == "weight" && !declared("weight"):
+			w, err := strconv.ParseFloat(a.Value, 64)
+			if err != nil {
+				return fmt.Errorf("%w: edge weight: %v", ErrSyntax, err)
+			}
+			attrs["weight"] = w
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestReadGEXF(t *testing.T) {
+	// as exported by Gephi, labels and weights are built in attributes
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://gexf.net/1.3" version="1.3">
+  <meta><creator>Gephi</creator></meta>
+  <graph defaultedgetype="directed">
+    <attributes class="node">
+      <attribute id="0" title="team" type="string"><default>core</default></attribute>
+    </attributes>
+    <attributes class="edge">
+      <attribute id="0" title="calls" type="integer"/>
+    </attributes>
+    <nodes>
+      <node id="0" label="api"><attvalues><attvalue for="0" value="edge"/></attvalues></node>
+      <node id="1" label="db"/>
+    </nodes>
+    <edges>
+      <edge id="0" source="0" target="1" weight="2.5"><attvalues><attvalue for="0" value="40"/></attvalues></edge>
+    </edges>
+  </graph>
+</gexf>`
+	type call struct {
+		weight float64
+		calls  int
+	}
+	codec := &XMLCodec[string, call]{
+		Vertex: func(id string, attrs map[string]any) (string, error) {
+			return attrs["label"].(string) + "/" + attrs["team"].(string), nil
+		},
+		Edge: func(attrs map[string]any) (call, error) {
+			return call{attrs["weight"].(float64), attrs["calls"].(int)}, nil
+		},
+	}
+	g, err := ReadGEXF(strings.NewReader(src), codec)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	dg, ok := g.(DirectedGraph[string, call])
+	if !ok {
+		t.Fatalf("expected a directed graph got %T", g)
+	}
+	if !dg.ContainsEdge(NewEdge("api/edge", "db/core", call{2.5, 40})) {
+		t.Fatalf("unexpected edges %v", dg.Edges())
+	}
+
+	for _, bad := range []string{
+		`<gexf><graph><nodes><node id="a"><attvalues><attvalue for="9" value="1"/></attvalues></node></nodes></graph></gexf>`,
+		`<gexf><graph><edges><edge id="0" source="a" target="b" weight="heavy"/></edges></graph></gexf>`,
+		`<gexf><graph><attributes class="node" mode="dynamic"></attributes></graph></gexf>`,
+		`<gexf><graph><nodes>`,
+	} {
+		if _, err := ReadGEXF(strings.NewReader(bad), codec); !errors.Is(err, ErrSyntax) {
+			t.Fatalf("expected ErrSyntax for %s got %v", bad, err)
+		}
+	}
+}
+
+func TestWriteGEXF(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	g.AddVertex(1)
+	g.AddVertex(2)
+	g.AddEdge(2, 1, "x")
+
+	var b strings.Builder
+	if err := WriteGEXF[int, string](&b, g, nil); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://gexf.net/1.3" version="1.3">
+  <graph defaultedgetype="undirected" mode="static">
+    <nodes>
+      <node id="1"></node>
+      <node id="2"></node>
+    </nodes>
+    <edges>
+      <edge id="0" source="1" target="2"></edge>
+    </edges>
+  </graph>
+</gexf>`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
@@ -1,17 +1,36 @@
 package graph
 
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
 type Graph[T comparable, D comparable] interface {
 	Vertices() []T
 	Edges() []Edge[T, D]
 	VectorEdges(v T) []Edge[T, D]
 	AddEdge(u T, v T, d D) (*Edge[T, D], error)
 	AddVertex(v T) error
-	RemoveVertex(v T)
-	RemoveEdge(e Edge[T, D])
+	RemoveVertex(v T) ([]Edge[T, D], error)
+	RemoveEdge(e Edge[T, D]) error
 	ContainsVertex(v T) bool
 	ContainsEdge(e Edge[T, D]) bool
 }
 
+// Directed graph, an Edge runs from u to v. VectorEdges returns the same edges
+// as OutEdges so algorithms written against Graph follow edge direction.
+type DirectedGraph[T comparable, D comparable] interface {
+	Graph[T, D]
+	OutEdges(v T) []Edge[T, D]
+	InEdges(v T) []Edge[T, D]
+	Successors(v T) []T
+	Predecessors(v T) []T
+	OutDegree(v T) int
+	InDegree(v T) int
+	Reverse() DirectedGraph[T, D]
+}
+
 type Edge[T comparable, D comparable] struct {
 	u T
 	v T
@@ -20,4 +39,81 @@ type Edge[T comparable, D comparable] struct {
 
 func NewEdge[T comparable, D comparable](u T, v T, d D) Edge[T, D] {
 	return Edge[T, D]{u: u, v: v, d: d}
-}
\ No newline at end of file
+}
+
+// U returns the first endpoint, the tail of a directed edge
+func (e Edge[T, D]) U() T {
+	return e.u
+}
+
+// V returns the second endpoint, the head of a directed edge
+func (e Edge[T, D]) V() T {
+	return e.v
+}
+
+// Data returns the data stored on the edge
+func (e Edge[T, D]) Data() D {
+	return e.d
+}
+
+// Endpoints returns both endpoints in stored order
+func (e Edge[T, D]) Endpoints() (T, T) {
+	return e.u, e.v
+}
+
+// Other returns the endpoint of the edge opposite to v
+func (e Edge[T, D]) Other(v T) T {
+	if v == e.u {
+		return e.v
+	}
+	return e.u
+}
+
+// Reversed returns a copy of the edge with its endpoints swapped
+func (e Edge[T, D]) Reversed() Edge[T, D] {
+	return Edge[T, D]{u: e.v, v: e.u, d: e.d}
+}
+
+func (e Edge[T, D]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", e.u, e.v, e.d)
+}
+
+// Format supports %s, %q and %v, %+v names the fields and %#v prints Go syntax
+func (e Edge[T, D]) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			fmt.Fprintf(f, "graph.Edge[%T,%T]{u:%#v, v:%#v, d:%#v}", e.u, e.d, e.u, e.v, e.d)
+		case f.Flag('+'):
+			fmt.Fprintf(f, "{u:%+v v:%+v d:%+v}", e.u, e.v, e.d)
+		default:
+			io.WriteString(f, e.String())
+		}
+	case 's':
+		io.WriteString(f, e.String())
+	case 'q':
+		fmt.Fprintf(f, "%q", e.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(graph.Edge=%s)", verb, e.String())
+	}
+}
+
+type jsonEdge[T comparable, D comparable] struct {
+	U    T `json:"u"`
+	V    T `json:"v"`
+	Data D `json:"data"`
+}
+
+func (e Edge[T, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonEdge[T, D]{U: e.u, V: e.v, Data: e.d})
+}
+
+func (e *Edge[T, D]) UnmarshalJSON(b []byte) error {
+	var j jsonEdge[T, D]
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	*e = NewEdge(j.U, j.V, j.Data)
+	return nil
+}
@@ -5,10 +5,19 @@ import (
 	"testing"
 )
 
+var (
+	_ Graph[int, string]         = (*adjacencyListGraph[int, string])(nil)
+	_ Graph[int, string]         = (*adjacencyMatrixGraph[int, string])(nil)
+	_ DirectedGraph[int, string] = (*directedAdjacencyListGraph[int, string])(nil)
+)
+
 func TestVertices(t *testing.T) {
-	var g Graph[int, string] = (*adjacencyListGraph[int, string])(nil)
-	g = NewAdjacencyListGraph[int, string]()
+	verticesTest(NewAdjacencyListGraph[int, string](), t)
+	verticesTest(NewAdjacencyMatrixGraph[int, string](), t)
+	verticesTest(NewDirectedAdjacencyListGraph[int, string](), t)
+}
 
+func verticesTest(g Graph[int, string], t *testing.T) {
 	g.AddVertex(0)
 	g.AddVertex(1)
 	g.AddVertex(2)
@@ -37,9 +46,12 @@ func TestVertices(t *testing.T) {
 }
 
 func TestEdges(t *testing.T) {
-	var g Graph[int, string] = (*adjacencyListGraph[int, string])(nil)
-	g = NewAdjacencyListGraph[int, string]()
+	edgesTest(NewAdjacencyListGraph[int, string](), t)
+	edgesTest(NewAdjacencyMatrixGraph[int, string](), t)
+	edgesTest(NewDirectedAdjacencyListGraph[int, string](), t)
+}
 
+func edgesTest(g Graph[int, string], t *testing.T) {
 	g.AddVertex(0)
 	g.AddVertex(1)
 	g.AddVertex(2)
@@ -82,9 +94,97 @@ func TestEdges(t *testing.T) {
 	}
 }
 
+func TestUndirectedEdgeIdentity(t *testing.T) {
+	undirectedEdgeIdentityTest(NewAdjacencyListGraph[int, string](), t)
+	undirectedEdgeIdentityTest(NewAdjacencyMatrixGraph[int, string](), t)
+}
+
+func undirectedEdgeIdentityTest(g Graph[int, string], t *testing.T) {
+	g.AddVertex(0)
+	g.AddVertex(1)
+	g.AddVertex(2)
+
+	e1, _ := g.AddEdge(0, 1, "0:1")
+	e2, err := g.AddEdge(1, 0, "1:0")
+	if err != nil || *e2 != *e1 {
+		t.Fatalf("expected 1:0 to return existing edge %v got %v", e1, e2)
+	}
+	if len(g.Edges()) != 1 {
+		t.Fatalf("expected 1 got %d", len(g.Edges()))
+	}
+	if !g.ContainsEdge(NewEdge(1, 0, "")) {
+		t.Fatal("expected graph contains 1:0")
+	}
+	if len(g.VectorEdges(0)) != 1 || len(g.VectorEdges(1)) != 1 {
+		t.Fatal("expected one vector edge on each endpoint")
+	}
+
+	g.AddEdge(2, 1, "2:1")
+	for _, e := range g.VectorEdges(1) {
+		if o := e.Other(1); o != 0 && o != 2 {
+			t.Fatalf("unexpected other endpoint %d", o)
+		}
+	}
+
+	g.RemoveEdge(NewEdge(1, 0, ""))
+	if g.ContainsEdge(*e1) {
+		t.Fatalf("unexpected %v in graph", e1)
+	}
+	if len(g.VectorEdges(0)) != 0 || len(g.VectorEdges(1)) != 1 {
+		t.Fatal("expected vector edges updated after remove")
+	}
+}
+
+func TestRemoveVertexCascade(t *testing.T) {
+	removeVertexCascadeTest(NewAdjacencyListGraph[int, string](), t)
+	removeVertexCascadeTest(NewAdjacencyMatrixGraph[int, string](), t)
+}
+
+func removeVertexCascadeTest(g Graph[int, string], t *testing.T) {
+	for i := 0; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(2, 0, "2:0")
+	g.AddEdge(2, 3, "2:3")
+
+	removed, err := g.RemoveVertex(0)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(removed) != 2 {
+		t.Fatalf("expected 2 removed edges got %d", len(removed))
+	}
+	for _, e := range removed {
+		if g.ContainsEdge(e) {
+			t.Fatalf("unexpected %v in graph", e)
+		}
+	}
+
+	edges := g.Edges()
+	if len(edges) != 1 || edges[0] != NewEdge(2, 3, "2:3") {
+		t.Fatalf("expected only 2:3 got %v", edges)
+	}
+	if len(g.VectorEdges(1)) != 0 || len(g.VectorEdges(2)) != 1 {
+		t.Fatal("expected neighbour vector edges to be updated")
+	}
+
+	for _, e := range removed {
+		g.RemoveEdge(e)
+	}
+
+	if _, err := g.RemoveVertex(0); err == nil {
+		t.Fatal("expected error removing unknown vertex 0")
+	}
+}
+
 func TestLargeFullyConnected(t *testing.T) {
-	var g Graph[int, string] = (*adjacencyListGraph[int, string])(nil)
-	g = NewAdjacencyListGraph[int, string]()
+	largeFullyConnectedTest(NewAdjacencyListGraph[int, string](), t)
+	largeFullyConnectedTest(NewAdjacencyMatrixGraph[int, string](), t)
+	largeFullyConnectedTest(NewDirectedAdjacencyListGraph[int, string](), t)
+}
+
+func largeFullyConnectedTest(g Graph[int, string], t *testing.T) {
 	numVertices := 999
 	for i := 0; i < numVertices; i++ {
 		g.AddVertex(i)
@@ -122,3 +222,39 @@ func TestLargeFullyConnected(t *testing.T) {
 	fmt.Println("vector edges check pass")
 
 }
+
+func TestMatrixRemoveVertex(t *testing.T) {
+	g := NewAdjacencyMatrixGraph[int, string]()
+	for i := 0; i <= 4; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 4, "1:4")
+	g.AddEdge(3, 4, "3:4")
+
+	g.RemoveVertex(1)
+	if g.ContainsVertex(1) {
+		t.Fatal("expected graph does not contain 1")
+	}
+	if len(g.Vertices()) != 4 {
+		t.Fatalf("expected 4 got %d", len(g.Vertices()))
+	}
+
+	edges := g.Edges()
+	if len(edges) != 1 || edges[0] != NewEdge(3, 4, "3:4") {
+		t.Fatalf("expected only 3:4 got %v", edges)
+	}
+	if !g.ContainsEdge(NewEdge(4, 3, "")) {
+		t.Fatal("expected 4:3 to match undirected edge 3:4")
+	}
+	if len(g.VectorEdges(4)) != 1 || len(g.VectorEdges(0)) != 0 {
+		t.Fatal("unexpected vector edges after remove")
+	}
+
+	if err := g.AddVertex(1); err != nil {
+		t.Fatalf("unexpected error re-adding 1: %v", err)
+	}
+	if len(g.VectorEdges(1)) != 0 {
+		t.Fatal("expected re-added vertex to have no edges")
+	}
+}
@@ -0,0 +1,201 @@
+package graph
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+var graphMLTypes = map[AttributeType]string{
+	StringAttribute: "string",
+	BoolAttribute:   "boolean",
+	IntAttribute:    "int",
+	LongAttribute:   "long",
+	FloatAttribute:  "float",
+	DoubleAttribute: "double",
+}
+
+// WriteGraphML writes g as GraphML, with a key element for each of the
+// codec's keys and edgedefault set by whether g is a DirectedGraph. Vertices
+// and edges are sorted by ID so the same graph always gives the same output.
+// codec may be nil to write the structure alone.
+func WriteGraphML[T comparable, D comparable](w io.Writer, g Graph[T, D], codec *XMLCodec[T, D]) error {
+	var c XMLCodec[T, D]
+	if codec != nil {
+		c = *codec
+	}
+	edgeDefault := "undirected"
+	_, directed := g.(DirectedGraph[T, D])
+	if directed {
+		edgeDefault = "directed"
+	}
+
+	x := newXMLWriter(w)
+	x.start("graphml", "xmlns", graphMLNamespace)
+	writeKey := func(id string, domain string, k XMLKey) error {
+		x.start("key", "id", id, "for", domain, "attr.name", k.Name, "attr.type", graphMLTypes[k.Type])
+		if k.Default != nil {
+			s, err := formatAttribute(k, k.Default)
+			if err != nil {
+				return err
+			}
+			x.element("default", s)
+		}
+		x.end("key")
+		return nil
+	}
+	for i, k := range c.VertexKeys {
+		if err := writeKey("v"+strconv.Itoa(i), "node", k); err != nil {
+			return err
+		}
+	}
+	for i, k := range c.EdgeKeys {
+		if err := writeKey("e"+strconv.Itoa(i), "edge", k); err != nil {
+			return err
+		}
+	}
+
+	x.start("graph", "id", "G", "edgedefault", edgeDefault)
+	vertices, ids := sortedByID(g, c.vertexID())
+	for _, v := range vertices {
+		x.start("node", "id", ids[v])
+		if c.VertexAttributes != nil {
+			err := xmlValues(c.VertexKeys, c.VertexAttributes(v), func(i int, s string) {
+				x.element("data", s, "key", "v"+strconv.Itoa(i))
+			})
+			if err != nil {
+				return err
+			}
+		}
+		x.end("node")
+	}
+	for _, ie := range edgesByID(g, ids, directed) {
+		x.start("edge", "source", ie.u, "target", ie.v)
+		if c.EdgeAttributes != nil {
+			err := xmlValues(c.EdgeKeys, c.EdgeAttributes(ie.e), func(i int, s string) {
+				x.element("data", s, "key", "e"+strconv.Itoa(i))
+			})
+			if err != nil {
+				return err
+			}
+		}
+		x.end("edge")
+	}
+	x.end("graph")
+	x.end("graphml")
+	return x.close()
+}
+
+// ReadGraphML reads a GraphML document into a new adjacency list graph,
+// directed unless edgedefault is undirected. The document is decoded a token
+// at a time so only the graph being built is held in memory. Data values are
+// converted to the Go type of their key's attr.type and keys with a default
+// fill in values a vertex or edge leaves out. Only the first graph is read and
+// nested graphs and hyperedges are not supported. Malformed documents are
+// reported as an error wrapping ErrSyntax.
+func ReadGraphML[T comparable, D comparable](r io.Reader, codec *XMLCodec[T, D]) (Graph[T, D], error) {
+	dec := xml.NewDecoder(r)
+	fail := func(err error) (Graph[T, D], error) {
+		line, _ := dec.InputPos()
+		var syntax *xml.SyntaxError
+		if errors.As(err, &syntax) {
+			return nil, fmt.Errorf("%w: graphml: %v", ErrSyntax, err)
+		}
+		return nil, fmt.Errorf("graph: graphml line %d: %w", line, err)
+	}
+
+	keys := make(map[string]XMLKey)
+	var vertexKeys, edgeKeys []XMLKey
+	var b *xmlBuilder[T, D]
+	var current *xml.StartElement
+	var attrs map[string]any
+	done := false
+
+	for !done {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "key":
+				k := XMLKey{Name: xmlAttr(t, "attr.name"), Type: attributeType(graphMLTypes, xmlAttr(t, "attr.type"))}
+				if err := readXMLKeyDefault(dec, &k); err != nil {
+					return fail(err)
+				}
+				keys[xmlAttr(t, "id")] = k
+				switch xmlAttr(t, "for") {
+				case "node":
+					vertexKeys = append(vertexKeys, k)
+				case "edge":
+					edgeKeys = append(edgeKeys, k)
+				case "all":
+					vertexKeys = append(vertexKeys, k)
+					edgeKeys = append(edgeKeys, k)
+				}
+			case "graph":
+				if b != nil {
+					return fail(fmt.Errorf("%w: nested graphs are not supported", ErrSyntax))
+				}
+				if b, err = newXMLBuilder(codec, xmlAttr(t, "edgedefault") != "undirected"); err != nil {
+					return nil, err
+				}
+			case "node", "edge":
+				if b == nil || current != nil {
+					return fail(fmt.Errorf("%w: unexpected %s", ErrSyntax, t.Name.Local))
+				}
+				current, attrs = &t, make(map[string]any)
+			case "hyperedge":
+				return fail(fmt.Errorf("%w: hyperedges are not supported", ErrSyntax))
+			case "data":
+				text, err := xmlText(dec, t)
+				if err != nil {
+					return fail(err)
+				}
+				k, ok := keys[xmlAttr(t, "key")]
+				if !ok {
+					return fail(fmt.Errorf("%w: undeclared key %q", ErrSyntax, xmlAttr(t, "key")))
+				}
+				if current == nil {
+					// graph data has nowhere to go
+					continue
+				}
+				if attrs[k.Name], err = parseAttribute(k, text); err != nil {
+					return fail(err)
+				}
+			}
+
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "node":
+				if err := b.vertex(xmlAttr(*current, "id"), attrs, vertexKeys); err != nil {
+					return fail(err)
+				}
+				current = nil
+			case "edge":
+				if err := b.edge(xmlAttr(*current, "source"), xmlAttr(*current, "target"), attrs, edgeKeys); err != nil {
+					return fail(err)
+				}
+				current = nil
+			case "graph":
+				if err := b.finish(); err != nil {
+					return fail(err)
+				}
+				done = true
+			}
+		}
+	}
+	if !done {
+		return nil, fmt.Errorf("%w: graphml: no complete graph element", ErrSyntax)
+	}
+	return b.g, nil
+}
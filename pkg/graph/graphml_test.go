@@ -0,0 +1,251 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type xmlTestEdge struct {
+	Label  string
+	Weight float64
+	Ratio  float32
+	Count  int64
+	Hops   int
+	Ok     bool
+}
+
+// xmlTestCodec converts every attribute type, read back vertex attributes
+// are recorded in read
+func xmlTestCodec(sizes map[string]int, read map[string]map[string]any) *XMLCodec[string, xmlTestEdge] {
+	return &XMLCodec[string, xmlTestEdge]{
+		VertexKeys: []XMLKey{
+			{Name: "size", Type: IntAttribute},
+			{Name: "colour", Type: StringAttribute, Default: "black"},
+		},
+		EdgeKeys: []XMLKey{
+			{Name: "label", Type: StringAttribute},
+			{Name: "weight", Type: DoubleAttribute},
+			{Name: "ratio", Type: FloatAttribute},
+			{Name: "count", Type: LongAttribute},
+			{Name: "hops", Type: IntAttribute},
+			{Name: "ok", Type: BoolAttribute},
+		},
+		VertexAttributes: func(v string) map[string]any {
+			if size, ok := sizes[v]; ok {
+				return map[string]any{"size": size}
+			}
+			return nil
+		},
+		EdgeAttributes: func(e Edge[string, xmlTestEdge]) map[string]any {
+			d := e.Data()
+			return map[string]any{"label": d.Label, "weight": d.Weight, "ratio": d.Ratio, "count": d.Count, "hops": d.Hops, "ok": d.Ok}
+		},
+		Vertex: func(id string, attrs map[string]any) (string, error) {
+			read[id] = attrs
+			return id, nil
+		},
+		Edge: func(attrs map[string]any) (xmlTestEdge, error) {
+			var d xmlTestEdge
+			d.Label, _ = attrs["label"].(string)
+			d.Weight, _ = attrs["weight"].(float64)
+			d.Ratio, _ = attrs["ratio"].(float32)
+			d.Count, _ = attrs["count"].(int64)
+			d.Hops, _ = attrs["hops"].(int)
+			d.Ok, _ = attrs["ok"].(bool)
+			return d, nil
+		},
+	}
+}
+
+func xmlTestGraph(g Graph[string, xmlTestEdge]) Graph[string, xmlTestEdge] {
+	for _, v := range []string{"a", "b", "c", "<d & e>"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", xmlTestEdge{Label: "", Weight: 0.1, Ratio: 1.0 / 3, Count: 1 << 40, Hops: -2, Ok: true})
+	g.AddEdge("c", "b", xmlTestEdge{Label: `"quoted" <tag>`, Weight: 1e-300})
+	g.AddEdge("a", "<d & e>", xmlTestEdge{Label: "x"})
+	return g
+}
+
+type xmlFormat struct {
+	name  string
+	write func(*strings.Builder, Graph[string, xmlTestEdge], *XMLCodec[string, xmlTestEdge]) error
+	read  func(*strings.Reader, *XMLCodec[string, xmlTestEdge]) (Graph[string, xmlTestEdge], error)
+}
+
+var xmlFormats = []xmlFormat{
+	{
+		"graphml",
+		func(b *strings.Builder, g Graph[string, xmlTestEdge], c *XMLCodec[string, xmlTestEdge]) error {
+			return WriteGraphML(b, g, c)
+		},
+		func(r *strings.Reader, c *XMLCodec[string, xmlTestEdge]) (Graph[string, xmlTestEdge], error) {
+			return ReadGraphML(r, c)
+		},
+	},
+	{
+		"gexf",
+		func(b *strings.Builder, g Graph[string, xmlTestEdge], c *XMLCodec[string, xmlTestEdge]) error {
+			return WriteGEXF(b, g, c)
+		},
+		func(r *strings.Reader, c *XMLCodec[string, xmlTestEdge]) (Graph[string, xmlTestEdge], error) {
+			return ReadGEXF(r, c)
+		},
+	},
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	sizes := map[string]int{"a": 3, "c": 0}
+	for _, f := range xmlFormats {
+		for _, g := range []Graph[string, xmlTestEdge]{
+			xmlTestGraph(NewAdjacencyListGraph[string, xmlTestEdge]()),
+			xmlTestGraph(NewDirectedAdjacencyListGraph[string, xmlTestEdge]()),
+		} {
+			read := make(map[string]map[string]any)
+			codec := xmlTestCodec(sizes, read)
+			var b strings.Builder
+			if err := f.write(&b, g, codec); err != nil {
+				t.Fatalf("%s: unexpected error %v", f.name, err)
+			}
+			r, err := f.read(strings.NewReader(b.String()), codec)
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v\n%s", f.name, err, b.String())
+			}
+
+			_, directed := g.(DirectedGraph[string, xmlTestEdge])
+			if _, ok := r.(DirectedGraph[string, xmlTestEdge]); ok != directed {
+				t.Fatalf("%s: expected directed %v got %T", f.name, directed, r)
+			}
+			if len(r.Vertices()) != 4 || len(r.Edges()) != 3 {
+				t.Fatalf("%s: expected 4 vertices and 3 edges got %v", f.name, r.Edges())
+			}
+			for _, e := range g.Edges() {
+				if !r.ContainsEdge(e) {
+					t.Fatalf("%s: expected edge %v got %v", f.name, e, r.Edges())
+				}
+				if d := edgeData(r, e); d != e.Data() {
+					t.Fatalf("%s: expected %+v got %+v", f.name, e.Data(), d)
+				}
+			}
+
+			expected := map[string]map[string]any{
+				"a":       {"size": 3, "colour": "black"},
+				"b":       {"colour": "black"},
+				"c":       {"size": 0, "colour": "black"},
+				"<d & e>": {"colour": "black"},
+			}
+			if !reflect.DeepEqual(read, expected) {
+				t.Fatalf("%s: expected vertex attributes %v got %v", f.name, expected, read)
+			}
+		}
+	}
+}
+
+// edgeData finds the data stored for e in either orientation
+func edgeData(g Graph[string, xmlTestEdge], e Edge[string, xmlTestEdge]) xmlTestEdge {
+	for _, f := range g.Edges() {
+		if f.U() == e.U() && f.V() == e.V() || f.U() == e.V() && f.V() == e.U() {
+			return f.Data()
+		}
+	}
+	return xmlTestEdge{}
+}
+
+func TestXMLWriteErrors(t *testing.T) {
+	codec := xmlTestCodec(nil, nil)
+	codec.VertexAttributes = func(string) map[string]any { return map[string]any{"size": "big"} }
+	g := xmlTestGraph(NewAdjacencyListGraph[string, xmlTestEdge]())
+	for _, f := range xmlFormats {
+		var b strings.Builder
+		if err := f.write(&b, g, codec); !errors.Is(err, ErrAttributeType) {
+			t.Fatalf("%s: expected ErrAttributeType got %v", f.name, err)
+		}
+	}
+}
+
+func TestWriteGraphML(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	g.AddVertex(1)
+	g.AddVertex(2)
+	g.AddEdge(2, 1, "x")
+
+	var b strings.Builder
+	err := WriteGraphML[int, string](&b, g, &XMLCodec[int, string]{
+		EdgeKeys:       []XMLKey{{Name: "name", Type: StringAttribute}},
+		EdgeAttributes: func(e Edge[int, string]) map[string]any { return map[string]any{"name": e.Data()} },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="e0" for="edge" attr.name="name" attr.type="string"></key>
+  <graph id="G" edgedefault="directed">
+    <node id="1"></node>
+    <node id="2"></node>
+    <edge source="2" target="1">
+      <data key="e0">x</data>
+    </edge>
+  </graph>
+</graphml>`
+	if b.String() != expected {
+		t.Fatalf("expected\n%s\ngot\n%s", expected, b.String())
+	}
+}
+
+func TestReadGraphML(t *testing.T) {
+	src := `<?xml version="1.0"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="w" for="all" attr.name="weight" attr.type="double"><default>1.5</default></key>
+  <key id="t" for="graph" attr.name="title" attr.type="string"/>
+  <graph edgedefault="undirected">
+    <data key="t">fixture</data>
+    <edge source="n0" target="n1"><data key="w">2</data></edge>
+    <node id="n0"/>
+    <node id="n1"><data key="w">3</data></node>
+    <edge source="n1" target="n2"/>
+    <node id="n2"/>
+  </graph>
+</graphml>`
+	weights := make(map[string]float64)
+	codec := &XMLCodec[string, float64]{
+		Vertex: func(id string, attrs map[string]any) (string, error) {
+			weights[id], _ = attrs["weight"].(float64)
+			return id, nil
+		},
+		Edge: func(attrs map[string]any) (float64, error) {
+			w, _ := attrs["weight"].(float64)
+			return w, nil
+		},
+	}
+	g, err := ReadGraphML(strings.NewReader(src), codec)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, ok := g.(DirectedGraph[string, float64]); ok {
+		t.Fatal("expected an undirected graph")
+	}
+	if !g.ContainsEdge(NewEdge("n0", "n1", 2.0)) || !g.ContainsEdge(NewEdge("n1", "n2", 1.5)) {
+		t.Fatalf("unexpected edges %v", g.Edges())
+	}
+	if expected := map[string]float64{"n0": 1.5, "n1": 3, "n2": 1.5}; !reflect.DeepEqual(weights, expected) {
+		t.Fatalf("expected %v got %v", expected, weights)
+	}
+
+	for _, bad := range []string{
+		`<graphml><graph><node id="a"><data key="missing">1</data></node></graph></graphml>`,
+		`<graphml><key id="w" for="node" attr.type="int"/><graph><node id="a"><data key="w">x</data></node></graph></graphml>`,
+		`<graphml><graph><node id="a">`,
+		`<graphml></graphml>`,
+	} {
+		if _, err := ReadGraphML(strings.NewReader(bad), codec); !errors.Is(err, ErrSyntax) {
+			t.Fatalf("expected ErrSyntax for %s got %v", bad, err)
+		}
+	}
+	bad := `<graphml><graph><node id="a"/><edge source="a" target="b"/></graph></graphml>`
+	if _, err := ReadGraphML(strings.NewReader(bad), codec); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+}
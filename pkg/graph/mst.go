@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/unionfind"
+	"sort"
+)
+
+// Kruskal computes a minimum spanning forest of an undirected graph by adding
+// edges in order of weight whenever they join two separate trees. The forest
+// is returned as a new graph holding every vertex of g, along with its total
+// weight.
+func Kruskal[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (Graph[T, D], W, error) {
+	forest, err := newSpanningForest(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	edges := g.Edges()
+	weights := make(map[Edge[T, D]]W, len(edges))
+	for _, e := range edges {
+		weights[e] = weight(e)
+	}
+	sort.SliceStable(edges, func(i, j int) bool {
+		return weights[edges[i]] < weights[edges[j]]
+	})
+
+	var total W
+	uf := unionfind.NewFrom(g.Vertices())
+	for _, e := range edges {
+		if uf.Union(e.u, e.v) {
+			forest.AddEdge(e.u, e.v, e.d)
+			total += weights[e]
+		}
+	}
+	return forest, total, nil
+}
+
+type primItem[T comparable, D comparable, W Weight] struct {
+	to T
+	e  Edge[T, D]
+	w  W
+}
+
+// Prim computes a minimum spanning forest of an undirected graph by growing
+// one tree at a time from its cheapest outgoing edge. The forest is returned
+// as a new graph holding every vertex of g, along with its total weight.
+func Prim[T comparable, D comparable, W Weight](g Graph[T, D], weight func(Edge[T, D]) W) (Graph[T, D], W, error) {
+	forest, err := newSpanningForest(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total W
+	inTree := make(map[T]bool)
+	pq := newPriorityQueue(func(a, b primItem[T, D, W]) bool { return a.w < b.w })
+	grow := func(v T) {
+		inTree[v] = true
+		for _, e := range g.VectorEdges(v) {
+			if to := e.Other(v); !inTree[to] {
+				pq.push(primItem[T, D, W]{to, e, weight(e)})
+			}
+		}
+	}
+
+	for _, root := range g.Vertices() {
+		if inTree[root] {
+			continue
+		}
+		grow(root)
+		for pq.Len() > 0 {
+			item := pq.pop()
+			if inTree[item.to] {
+				continue
+			}
+			forest.AddEdge(item.e.u, item.e.v, item.e.d)
+			total += item.w
+			grow(item.to)
+		}
+	}
+	return forest, total, nil
+}
+
+func newSpanningForest[T comparable, D comparable](g Graph[T, D]) (Graph[T, D], error) {
+	if _, ok := g.(DirectedGraph[T, D]); ok {
+		return nil, ErrDirected
+	}
+	forest := NewAdjacencyListGraph[T, D]()
+	for _, v := range g.Vertices() {
+		forest.AddVertex(v)
+	}
+	return forest, nil
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+type spanningForestFunc func(Graph[string, int], func(Edge[string, int]) int) (Graph[string, int], int, error)
+
+func TestMinimumSpanningForest(t *testing.T) {
+	for _, mst := range []spanningForestFunc{Kruskal[string, int, int], Prim[string, int, int]} {
+		minimumSpanningForestTest(NewAdjacencyListGraph[string, int](), mst, t)
+		minimumSpanningForestTest(NewAdjacencyMatrixGraph[string, int](), mst, t)
+	}
+}
+
+func minimumSpanningForestTest(g Graph[string, int], mst spanningForestFunc, t *testing.T) {
+	for _, v := range []string{"a", "b", "c", "d", "e", "x", "y"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddEdge("b", "d", 5)
+	g.AddEdge("c", "d", 8)
+	g.AddEdge("d", "e", 3)
+	g.AddEdge("c", "e", 9)
+	g.AddEdge("x", "y", 6)
+
+	forest, total, err := mst(g, intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if total != 17 {
+		t.Fatalf("expected 17 got %d", total)
+	}
+	if len(forest.Vertices()) != 7 || len(forest.Edges()) != 5 {
+		t.Fatalf("expected 7 vertices and 5 edges got %d and %d", len(forest.Vertices()), len(forest.Edges()))
+	}
+	for _, e := range []Edge[string, int]{NewEdge("a", "c", 1), NewEdge("b", "c", 2), NewEdge("b", "d", 5), NewEdge("d", "e", 3), NewEdge("x", "y", 6)} {
+		if !forest.ContainsEdge(e) {
+			t.Fatalf("expected %v in spanning forest", e)
+		}
+	}
+	if !IsAcyclic(forest) || ConnectedComponents(forest).Len() != 2 {
+		t.Fatal("expected a forest of two trees")
+	}
+}
+
+func TestMinimumSpanningForestDirected(t *testing.T) {
+	g := weightedTestGraph(NewDirectedAdjacencyListGraph[string, int]())
+	if _, _, err := Kruskal(g, intWeight); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+	if _, _, err := Prim(g, intWeight); !errors.Is(err, ErrDirected) {
+		t.Fatalf("expected ErrDirected got %v", err)
+	}
+}
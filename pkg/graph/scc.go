@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"mervynrussell/gocol/pkg/stack"
+)
+
+// StronglyConnectedComponents partitions g into sets of mutually reachable
+// vertices using Tarjan's algorithm. Components come out in reverse
+// topological order of the condensation, a component is listed before any
+// component with an edge into it.
+func StronglyConnectedComponents[T comparable, D comparable](g DirectedGraph[T, D]) []set.Set[T] {
+	components := make([]set.Set[T], 0)
+	index := make(map[T]int)
+	low := make(map[T]int)
+	parent := make(map[T]T)
+	onStack := make(map[T]bool)
+	stk := stack.New[T](false)
+
+	nonTree := func(from T, e Edge[T, D]) bool {
+		if onStack[e.v] && index[e.v] < low[from] {
+			low[from] = index[e.v]
+		}
+		return true
+	}
+	vis := &Visitor[T, D]{
+		DiscoverVertex: func(v T) bool {
+			index[v] = len(index)
+			low[v] = index[v]
+			stk.Push(v)
+			onStack[v] = true
+			return true
+		},
+		TreeEdge: func(from T, e Edge[T, D]) bool {
+			parent[e.v] = from
+			return true
+		},
+		BackEdge:    nonTree,
+		ForwardEdge: nonTree,
+		CrossEdge:   nonTree,
+		FinishVertex: func(v T) bool {
+			if low[v] == index[v] {
+				component := set.New[T]()
+				for {
+					w := *stk.Pop()
+					onStack[w] = false
+					component.Add(w)
+					if w == v {
+						break
+					}
+				}
+				components = append(components, component)
+			}
+			if p, ok := parent[v]; ok && low[v] < low[p] {
+				low[p] = low[v]
+			}
+			return true
+		},
+	}
+	DFSForest[T, D](g, vis, nil)
+	return components
+}
+
+// Condensation collapses every strongly connected component of g into a
+// single vertex, the result is a DAG whose vertices are the component sets.
+// An edge between two components carries the number of edges of g it
+// replaces. The returned map gives the component of each vertex of g.
+func Condensation[T comparable, D comparable](g DirectedGraph[T, D]) (DirectedGraph[set.Set[T], int], map[T]set.Set[T]) {
+	components := StronglyConnectedComponents(g)
+	componentOf := make(map[T]set.Set[T])
+	c := NewDirectedAdjacencyListGraph[set.Set[T], int]()
+	for _, component := range components {
+		c.AddVertex(component)
+		for _, v := range component.All() {
+			componentOf[v] = component
+		}
+	}
+
+	counts := make(map[internalEdge[set.Set[T]]]int)
+	for _, e := range g.Edges() {
+		cu, cv := componentOf[e.u], componentOf[e.v]
+		if cu != cv {
+			counts[newInternalEdge(cu, cv)]++
+		}
+	}
+	for e, n := range counts {
+		c.AddEdge(e.u, e.v, n)
+	}
+	return c, componentOf
+}
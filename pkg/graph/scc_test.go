@@ -0,0 +1,83 @@
+package graph
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"testing"
+)
+
+// a <-> b -> c <-> d -> e, c -> f -> g -> c
+func sccTestGraph() DirectedGraph[string, int] {
+	g := NewDirectedAdjacencyListGraph[string, int]()
+	for _, v := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", 0)
+	g.AddEdge("b", "a", 0)
+	g.AddEdge("b", "c", 0)
+	g.AddEdge("a", "c", 0)
+	g.AddEdge("c", "d", 0)
+	g.AddEdge("d", "c", 0)
+	g.AddEdge("d", "e", 0)
+	g.AddEdge("c", "f", 0)
+	g.AddEdge("f", "g", 0)
+	g.AddEdge("g", "c", 0)
+	return g
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	g := sccTestGraph()
+	components := StronglyConnectedComponents(g)
+	if len(components) != 3 {
+		t.Fatalf("expected 3 components got %d", len(components))
+	}
+
+	expected := []set.Set[string]{
+		set.NewFrom([]string{"a", "b"}),
+		set.NewFrom([]string{"c", "d", "f", "g"}),
+		set.NewFrom([]string{"e"}),
+	}
+	position := make(map[string]int)
+	for _, e := range expected {
+		found := false
+		for i, c := range components {
+			if c.Equals(e) {
+				found = true
+				for _, v := range e.All() {
+					position[v] = i
+				}
+			}
+		}
+		if !found {
+			t.Fatalf("expected component %v", e.All())
+		}
+	}
+	// reverse topological order, e's component before c's before a's
+	if !(position["e"] < position["c"] && position["c"] < position["a"]) {
+		t.Fatalf("unexpected component order %v", position)
+	}
+}
+
+func TestCondensation(t *testing.T) {
+	g := sccTestGraph()
+	c, componentOf := Condensation(g)
+	if len(c.Vertices()) != 3 || len(c.Edges()) != 2 {
+		t.Fatalf("expected 3 vertices and 2 edges got %d and %d", len(c.Vertices()), len(c.Edges()))
+	}
+	if !IsAcyclic[set.Set[string], int](c) {
+		t.Fatal("expected condensation to be acyclic")
+	}
+
+	ab, cdfg := componentOf["a"], componentOf["c"]
+	if ab != componentOf["b"] || cdfg != componentOf["g"] || ab == cdfg {
+		t.Fatal("unexpected component mapping")
+	}
+	edges := c.OutEdges(ab)
+	if len(edges) != 1 || edges[0].V() != cdfg || edges[0].Data() != 2 {
+		t.Fatalf("expected a single edge replacing a:c and b:c got %v", edges)
+	}
+
+	order, err := TopologicalSort(c)
+	if err != nil || order[0] != ab {
+		t.Fatalf("expected a's component first got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package graph
+
+import "slices"
+
+// Weight is the set of numeric types usable as edge weights
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Path through a graph, Edges[i] joins Vertices[i] and Vertices[i+1] and
+// Weight is the sum of the edge weights
+type Path[T comparable, D comparable, W Weight] struct {
+	Vertices []T
+	Edges    []Edge[T, D]
+	Weight   W
+}
+
+// ShortestPaths from a single source. Dist holds the distance to every
+// reachable vertex and Pred the edge each one other than the source was
+// reached through.
+type ShortestPaths[T comparable, D comparable, W Weight] struct {
+	Source T
+	Dist   map[T]W
+	Pred   map[T]Edge[T, D]
+}
+
+// DistanceTo returns the distance from the source to v, false if v is not
+// reachable
+func (s *ShortestPaths[T, D, W]) DistanceTo(v T) (W, bool) {
+	d, ok := s.Dist[v]
+	return d, ok
+}
+
+// PathTo reconstructs the shortest path from the source to v
+func (s *ShortestPaths[T, D, W]) PathTo(v T) (*Path[T, D, W], error) {
+	d, ok := s.Dist[v]
+	if !ok {
+		return nil, &VertexError[T]{Vertex: v, Err: ErrNoPath}
+	}
+
+	vertices := []T{v}
+	edges := make([]Edge[T, D], 0)
+	for v != s.Source && len(edges) < len(s.Dist) {
+		e := s.Pred[v]
+		v = e.Other(v)
+		vertices = append(vertices, v)
+		edges = append(edges, e)
+	}
+	slices.Reverse(vertices)
+	slices.Reverse(edges)
+	return &Path[T, D, W]{Vertices: vertices, Edges: edges, Weight: d}, nil
+}
+
+// Dijkstra computes shortest paths from source to every reachable vertex.
+// Weights must not be negative, the first negative weight seen is reported as
+// an EdgeError wrapping ErrNegativeWeight.
+func Dijkstra[T comparable, D comparable, W Weight](g Graph[T, D], source T, weight func(Edge[T, D]) W) (*ShortestPaths[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	return dijkstra(g, source, nil, edgeWeight(weight))
+}
+
+// DijkstraPath computes the shortest path from source to target, stopping as
+// soon as target is settled
+func DijkstraPath[T comparable, D comparable, W Weight](g Graph[T, D], source T, target T, weight func(Edge[T, D]) W) (*Path[T, D, W], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	if !g.ContainsVertex(target) {
+		return nil, vertexNotFound(target)
+	}
+	sp, err := dijkstra(g, source, &target, edgeWeight(weight))
+	if err != nil {
+		return nil, err
+	}
+	return sp.PathTo(target)
+}
+
+type distanceItem[T comparable, W Weight] struct {
+	v T
+	d W
+}
+
+// arcWeight is the weight of e when it is followed from the vertex from,
+// it allows weights that depend on the direction an undirected edge is used
+type arcWeight[T comparable, D comparable, W Weight] func(from T, e Edge[T, D]) W
+
+func edgeWeight[T comparable, D comparable, W Weight](weight func(Edge[T, D]) W) arcWeight[T, D, W] {
+	return func(_ T, e Edge[T, D]) W {
+		return weight(e)
+	}
+}
+
+func dijkstra[T comparable, D comparable, W Weight](g Graph[T, D], source T, target *T, weight arcWeight[T, D, W]) (*ShortestPaths[T, D, W], error) {
+	sp := &ShortestPaths[T, D, W]{
+		Source: source,
+		Dist:   map[T]W{source: 0},
+		Pred:   make(map[T]Edge[T, D]),
+	}
+	settled := make(map[T]bool)
+	pq := newPriorityQueue(func(a, b distanceItem[T, W]) bool { return a.d < b.d })
+	pq.push(distanceItem[T, W]{source, 0})
+
+	for pq.Len() > 0 {
+		item := pq.pop()
+		if settled[item.v] {
+			continue
+		}
+		settled[item.v] = true
+		if target != nil && item.v == *target {
+			break
+		}
+
+		for _, e := range g.VectorEdges(item.v) {
+			w := weight(item.v, e)
+			if w < 0 {
+				return nil, &EdgeError[T]{U: e.u, V: e.v, Err: ErrNegativeWeight}
+			}
+			to := e.Other(item.v)
+			if settled[to] {
+				continue
+			}
+			d := item.d + w
+			if current, ok := sp.Dist[to]; !ok || d < current {
+				sp.Dist[to] = d
+				sp.Pred[to] = e
+				pq.push(distanceItem[T, W]{to, d})
+			}
+		}
+	}
+	return sp, nil
+}
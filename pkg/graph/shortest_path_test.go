@@ -0,0 +1,105 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func intWeight(e Edge[string, int]) int {
+	return e.Data()
+}
+
+// a:b 1, b:c 2, c:d 1, a:d 7 and e on its own
+func weightedTestGraph(g Graph[string, int]) Graph[string, int] {
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 2)
+	g.AddEdge("c", "d", 1)
+	g.AddEdge("a", "d", 7)
+	return g
+}
+
+func TestDijkstra(t *testing.T) {
+	dijkstraTest(weightedTestGraph(NewAdjacencyListGraph[string, int]()), t)
+	dijkstraTest(weightedTestGraph(NewAdjacencyMatrixGraph[string, int]()), t)
+}
+
+func dijkstraTest(g Graph[string, int], t *testing.T) {
+	sp, err := Dijkstra(g, "a", intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := map[string]int{"a": 0, "b": 1, "c": 3, "d": 4}
+	if !reflect.DeepEqual(sp.Dist, expected) {
+		t.Fatalf("expected %v got %v", expected, sp.Dist)
+	}
+	if _, ok := sp.DistanceTo("e"); ok {
+		t.Fatal("expected e to be unreachable")
+	}
+
+	p, err := sp.PathTo("d")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(p.Vertices, []string{"a", "b", "c", "d"}) || len(p.Edges) != 3 || p.Weight != 4 {
+		t.Fatalf("unexpected path %v", p)
+	}
+
+	if _, err := sp.PathTo("e"); !errors.Is(err, ErrNoPath) {
+		t.Fatalf("expected ErrNoPath got %v", err)
+	}
+
+	p, err = DijkstraPath(g, "d", "a", intWeight)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(p.Vertices, []string{"d", "c", "b", "a"}) || p.Weight != 4 {
+		t.Fatalf("unexpected path %v", p)
+	}
+
+	p, err = DijkstraPath(g, "a", "a", intWeight)
+	if err != nil || len(p.Vertices) != 1 || len(p.Edges) != 0 || p.Weight != 0 {
+		t.Fatalf("expected empty path got %v %v", p, err)
+	}
+
+	if _, err := DijkstraPath(g, "a", "z", intWeight); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+}
+
+func TestDijkstraDirected(t *testing.T) {
+	g := weightedTestGraph(NewDirectedAdjacencyListGraph[string, int]())
+	sp, _ := Dijkstra(g, "c", intWeight)
+	if _, ok := sp.DistanceTo("a"); ok {
+		t.Fatal("expected a to be unreachable from c")
+	}
+	if d, _ := sp.DistanceTo("d"); d != 1 {
+		t.Fatalf("expected 1 got %d", d)
+	}
+}
+
+func TestDijkstraFloatWeights(t *testing.T) {
+	g := weightedTestGraph(NewAdjacencyListGraph[string, int]())
+	p, err := DijkstraPath(g, "a", "d", func(e Edge[string, int]) float64 {
+		return 1 / float64(e.Data())
+	})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(p.Vertices, []string{"a", "d"}) {
+		t.Fatalf("expected direct a:d got %v", p.Vertices)
+	}
+}
+
+func TestDijkstraNegativeWeight(t *testing.T) {
+	g := weightedTestGraph(NewAdjacencyListGraph[string, int]())
+	g.AddEdge("b", "d", -1)
+	_, err := Dijkstra(g, "a", intWeight)
+	var eErr *EdgeError[string]
+	if !errors.Is(err, ErrNegativeWeight) || !errors.As(err, &eErr) {
+		t.Fatalf("expected ErrNegativeWeight got %v", err)
+	}
+}
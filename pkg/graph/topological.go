@@ -0,0 +1,130 @@
+package graph
+
+import "slices"
+
+// TopologicalSort orders the vertices of a DAG so every edge points forward
+// using Kahn's algorithm. A graph with a cycle returns a CycleError.
+func TopologicalSort[T comparable, D comparable](g DirectedGraph[T, D]) ([]T, error) {
+	inDegree := make(map[T]int)
+	queue := make([]T, 0)
+	for _, v := range g.Vertices() {
+		inDegree[v] = g.InDegree(v)
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]T, 0, len(inDegree))
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		order = append(order, u)
+		for _, e := range g.OutEdges(u) {
+			inDegree[e.v]--
+			if inDegree[e.v] == 0 {
+				queue = append(queue, e.v)
+			}
+		}
+	}
+	if len(order) < len(inDegree) {
+		return nil, findCycle[T, D](g)
+	}
+	return order, nil
+}
+
+// LexicographicTopologicalSort is TopologicalSort where, of the vertices free
+// to go next, the smallest by less always goes first. The order is the same
+// on every run.
+func LexicographicTopologicalSort[T comparable, D comparable](g DirectedGraph[T, D], less func(a, b T) bool) ([]T, error) {
+	inDegree := make(map[T]int)
+	pq := newPriorityQueue(less)
+	for _, v := range g.Vertices() {
+		inDegree[v] = g.InDegree(v)
+		if inDegree[v] == 0 {
+			pq.push(v)
+		}
+	}
+
+	order := make([]T, 0, len(inDegree))
+	for pq.Len() > 0 {
+		u := pq.pop()
+		order = append(order, u)
+		for _, e := range g.OutEdges(u) {
+			inDegree[e.v]--
+			if inDegree[e.v] == 0 {
+				pq.push(e.v)
+			}
+		}
+	}
+	if len(order) < len(inDegree) {
+		return nil, findCycle[T, D](g)
+	}
+	return order, nil
+}
+
+// TopologicalSortDFS orders the vertices of a DAG by reverse DFS finish time.
+// A graph with a cycle returns a CycleError.
+func TopologicalSortDFS[T comparable, D comparable](g DirectedGraph[T, D]) ([]T, error) {
+	order, cycle := reversePostorder[T, D](g)
+	if cycle != nil {
+		return nil, cycle
+	}
+	return order, nil
+}
+
+// IsAcyclic reports whether g has no cycle, directed graphs are checked for
+// directed cycles
+func IsAcyclic[T comparable, D comparable](g Graph[T, D]) bool {
+	_, cycle := reversePostorder(g)
+	return cycle == nil
+}
+
+func findCycle[T comparable, D comparable](g Graph[T, D]) error {
+	_, cycle := reversePostorder(g)
+	return cycle
+}
+
+// reversePostorder runs DFS over the whole graph, stopping at the first back
+// edge and returning the cycle it closes
+func reversePostorder[T comparable, D comparable](g Graph[T, D]) ([]T, *CycleError[T, D]) {
+	order := make([]T, 0)
+	parentEdge := make(map[T]Edge[T, D])
+	var cycle *CycleError[T, D]
+	vis := &Visitor[T, D]{
+		TreeEdge: func(from T, e Edge[T, D]) bool {
+			parentEdge[e.Other(from)] = e
+			return true
+		},
+		BackEdge: func(from T, e Edge[T, D]) bool {
+			cycle = treeCycle(from, e, parentEdge)
+			return false
+		},
+		FinishVertex: func(v T) bool {
+			order = append(order, v)
+			return true
+		},
+	}
+	DFSForest(g, vis, nil)
+	if cycle != nil {
+		return nil, cycle
+	}
+	slices.Reverse(order)
+	return order, nil
+}
+
+// treeCycle builds the cycle closed by the back edge e from u to one of its
+// ancestors, following parentEdge up the DFS tree
+func treeCycle[T comparable, D comparable](u T, e Edge[T, D], parentEdge map[T]Edge[T, D]) *CycleError[T, D] {
+	ancestor := e.Other(u)
+	vertices := []T{u}
+	edges := make([]Edge[T, D], 0)
+	for v := u; v != ancestor; {
+		pe := parentEdge[v]
+		v = pe.Other(v)
+		vertices = append(vertices, v)
+		edges = append(edges, pe)
+	}
+	slices.Reverse(vertices)
+	slices.Reverse(edges)
+	return &CycleError[T, D]{Vertices: append(vertices, ancestor), Edges: append(edges, e)}
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// build -> test -> package, build -> lint, docs on its own
+func dagTestGraph() DirectedGraph[string, int] {
+	g := NewDirectedAdjacencyListGraph[string, int]()
+	for _, v := range []string{"package", "test", "lint", "build", "docs"} {
+		g.AddVertex(v)
+	}
+	g.AddEdge("build", "test", 0)
+	g.AddEdge("test", "package", 0)
+	g.AddEdge("build", "lint", 0)
+	g.AddEdge("lint", "package", 0)
+	return g
+}
+
+func checkTopologicalOrder(g DirectedGraph[string, int], order []string, t *testing.T) {
+	if len(order) != len(g.Vertices()) {
+		t.Fatalf("expected every vertex got %v", order)
+	}
+	position := make(map[string]int)
+	for i, v := range order {
+		position[v] = i
+	}
+	for _, e := range g.Edges() {
+		if position[e.U()] > position[e.V()] {
+			t.Fatalf("%v points backwards in %v", e, order)
+		}
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	g := dagTestGraph()
+	order, err := TopologicalSort(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkTopologicalOrder(g, order, t)
+
+	order, err = TopologicalSortDFS(g)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	checkTopologicalOrder(g, order, t)
+
+	less := func(a, b string) bool { return a < b }
+	expected := []string{"build", "docs", "lint", "test", "package"}
+	for i := 0; i < 5; i++ {
+		order, err = LexicographicTopologicalSort(g, less)
+		if err != nil || !reflect.DeepEqual(order, expected) {
+			t.Fatalf("expected %v got %v %v", expected, order, err)
+		}
+	}
+
+	if !IsAcyclic[string, int](g) {
+		t.Fatal("expected DAG to be acyclic")
+	}
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := dagTestGraph()
+	g.AddEdge("package", "build", 0)
+
+	sorts := map[string]func(DirectedGraph[string, int]) ([]string, error){
+		"kahn": TopologicalSort[string, int],
+		"dfs":  TopologicalSortDFS[string, int],
+		"lexicographic": func(g DirectedGraph[string, int]) ([]string, error) {
+			return LexicographicTopologicalSort(g, func(a, b string) bool { return a < b })
+		},
+	}
+	for name, sort := range sorts {
+		_, err := sort(g)
+		var cycle *CycleError[string, int]
+		if !errors.Is(err, ErrCycle) || !errors.As(err, &cycle) {
+			t.Fatalf("%s expected cycle error got %v", name, err)
+		}
+		n := len(cycle.Vertices)
+		if cycle.Vertices[0] != cycle.Vertices[n-1] || len(cycle.Edges) != n-1 || n != 4 {
+			t.Fatalf("%s unexpected cycle %v", name, cycle.Vertices)
+		}
+		for i, e := range cycle.Edges {
+			if e.U() != cycle.Vertices[i] || e.V() != cycle.Vertices[i+1] {
+				t.Fatalf("%s edge %v does not follow cycle %v", name, e, cycle.Vertices)
+			}
+		}
+	}
+
+	if IsAcyclic[string, int](g) {
+		t.Fatal("expected cycle to be found")
+	}
+}
+
+func TestIsAcyclicUndirected(t *testing.T) {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+	g.AddEdge(1, 3, "1:3")
+	if !IsAcyclic[int, string](g) {
+		t.Fatal("expected tree to be acyclic")
+	}
+	g.AddEdge(3, 0, "3:0")
+	if IsAcyclic[int, string](g) {
+		t.Fatal("expected cycle to be found")
+	}
+}
@@ -0,0 +1,282 @@
+package graph
+
+import "mervynrussell/gocol/pkg/stack"
+
+// Visitor hooks called during BFS and DFS, any hook may be left nil. Edge hooks
+// receive the vertex the edge was reached from, for undirected graphs this is
+// how to tell which end of the edge the traversal is on. Returning false from
+// any hook stops the traversal.
+//
+// ExamineEdge sees every edge leaving a vertex, for undirected graphs that
+// means each edge is examined from both of its endpoints. The classification
+// hooks are called once per edge: DFS reports tree, back, forward and cross
+// edges, BFS reports tree, back and cross edges. Undirected graphs only have
+// tree and back edges under DFS and tree and cross edges under BFS.
+type Visitor[T comparable, D comparable] struct {
+	DiscoverVertex func(v T) bool
+	ExamineEdge    func(from T, e Edge[T, D]) bool
+	TreeEdge       func(from T, e Edge[T, D]) bool
+	BackEdge       func(from T, e Edge[T, D]) bool
+	ForwardEdge    func(from T, e Edge[T, D]) bool
+	CrossEdge      func(from T, e Edge[T, D]) bool
+	FinishVertex   func(v T) bool
+}
+
+// TraversalOptions for BFS and DFS. Vertices at MaxDepth are discovered but
+// their edges are not followed, zero means no limit.
+type TraversalOptions struct {
+	MaxDepth int
+}
+
+// Traversal is the record of a BFS or DFS. Discover and Finish hold the
+// timestamps of each vertex, one clock ticks for both so for DFS the interval
+// of a descendant nests inside that of its ancestor.
+type Traversal[T comparable] struct {
+	Order    []T
+	Parent   map[T]T
+	Depth    map[T]int
+	Discover map[T]int
+	Finish   map[T]int
+	Stopped  bool
+}
+
+type traversal[T comparable, D comparable] struct {
+	g        Graph[T, D]
+	vis      *Visitor[T, D]
+	maxDepth int
+	directed bool
+	time     int
+	r        *Traversal[T]
+}
+
+type dfsFrame[T comparable, D comparable] struct {
+	v     T
+	edges []Edge[T, D]
+	i     int
+}
+
+func newTraversal[T comparable, D comparable](g Graph[T, D], vis *Visitor[T, D], opts *TraversalOptions) *traversal[T, D] {
+	if vis == nil {
+		vis = &Visitor[T, D]{}
+	}
+	t := &traversal[T, D]{
+		g:   g,
+		vis: vis,
+		r: &Traversal[T]{
+			Order:    make([]T, 0),
+			Parent:   make(map[T]T),
+			Depth:    make(map[T]int),
+			Discover: make(map[T]int),
+			Finish:   make(map[T]int),
+		},
+	}
+	if opts != nil {
+		t.maxDepth = opts.MaxDepth
+	}
+	_, t.directed = g.(DirectedGraph[T, D])
+	return t
+}
+
+// BFS traverses g breadth first from source
+func BFS[T comparable, D comparable](g Graph[T, D], source T, vis *Visitor[T, D], opts *TraversalOptions) (*Traversal[T], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	t := newTraversal(g, vis, opts)
+	t.bfs(source)
+	return t.r, nil
+}
+
+// DFS traverses g depth first from source
+func DFS[T comparable, D comparable](g Graph[T, D], source T, vis *Visitor[T, D], opts *TraversalOptions) (*Traversal[T], error) {
+	if !g.ContainsVertex(source) {
+		return nil, vertexNotFound(source)
+	}
+	t := newTraversal(g, vis, opts)
+	t.dfs(source)
+	return t.r, nil
+}
+
+// DFSForest runs DFS from every vertex not yet discovered so the whole graph
+// is covered, timestamps continue from one tree to the next
+func DFSForest[T comparable, D comparable](g Graph[T, D], vis *Visitor[T, D], opts *TraversalOptions) *Traversal[T] {
+	t := newTraversal(g, vis, opts)
+	for _, v := range g.Vertices() {
+		if _, ok := t.r.Discover[v]; !ok {
+			if !t.dfs(v) {
+				break
+			}
+		}
+	}
+	return t.r
+}
+
+func (t *traversal[T, D]) bfs(source T) bool {
+	if !t.discover(source, 0) {
+		return false
+	}
+	queue := []T{source}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+
+		if t.expand(u) {
+			for _, e := range t.g.VectorEdges(u) {
+				w := e.Other(u)
+				if !t.edgeHook(t.vis.ExamineEdge, u, e) {
+					return false
+				}
+
+				var ok bool
+				switch {
+				case !t.discovered(w):
+					t.r.Parent[w] = u
+					ok = t.edgeHook(t.vis.TreeEdge, u, e) && t.discover(w, t.r.Depth[u]+1)
+					queue = append(queue, w)
+				case !t.directed:
+					// the tree edge from the parent and non tree edges to a
+					// finished vertex were already reported from the other end
+					if t.isParent(w, u) || t.finished(w) {
+						continue
+					}
+					ok = t.edgeHook(t.vis.CrossEdge, u, e)
+				case t.isAncestor(w, u):
+					ok = t.edgeHook(t.vis.BackEdge, u, e)
+				default:
+					ok = t.edgeHook(t.vis.CrossEdge, u, e)
+				}
+				if !ok {
+					return false
+				}
+			}
+		}
+
+		if !t.finish(u) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *traversal[T, D]) dfs(root T) bool {
+	if !t.discover(root, 0) {
+		return false
+	}
+	stk := stack.New[dfsFrame[T, D]](false)
+	stk.Push(t.frame(root))
+
+	for stk.Len() > 0 {
+		f := stk.Peek()
+		if f.i == len(f.edges) {
+			stk.Pop()
+			if !t.finish(f.v) {
+				return false
+			}
+			continue
+		}
+
+		u := f.v
+		e := f.edges[f.i]
+		f.i++
+		w := e.Other(u)
+		if !t.edgeHook(t.vis.ExamineEdge, u, e) {
+			return false
+		}
+
+		var ok bool
+		switch {
+		case !t.discovered(w):
+			t.r.Parent[w] = u
+			ok = t.edgeHook(t.vis.TreeEdge, u, e) && t.discover(w, t.r.Depth[u]+1)
+			stk.Push(t.frame(w))
+		case !t.finished(w):
+			if !t.directed && t.isParent(w, u) {
+				continue
+			}
+			ok = t.edgeHook(t.vis.BackEdge, u, e)
+		case !t.directed:
+			// reported as a back edge when it was examined from w
+			continue
+		case t.r.Discover[u] < t.r.Discover[w]:
+			ok = t.edgeHook(t.vis.ForwardEdge, u, e)
+		default:
+			ok = t.edgeHook(t.vis.CrossEdge, u, e)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *traversal[T, D]) frame(v T) dfsFrame[T, D] {
+	f := dfsFrame[T, D]{v: v}
+	if t.expand(v) {
+		f.edges = t.g.VectorEdges(v)
+	}
+	return f
+}
+
+func (t *traversal[T, D]) expand(v T) bool {
+	return t.maxDepth <= 0 || t.r.Depth[v] < t.maxDepth
+}
+
+func (t *traversal[T, D]) discovered(v T) bool {
+	_, ok := t.r.Discover[v]
+	return ok
+}
+
+func (t *traversal[T, D]) finished(v T) bool {
+	_, ok := t.r.Finish[v]
+	return ok
+}
+
+// isParent reports whether p is the tree parent of v
+func (t *traversal[T, D]) isParent(p T, v T) bool {
+	parent, ok := t.r.Parent[v]
+	return ok && parent == p
+}
+
+// isAncestor reports whether a is on the tree path from the root to v
+func (t *traversal[T, D]) isAncestor(a T, v T) bool {
+	for {
+		if v == a {
+			return true
+		}
+		p, ok := t.r.Parent[v]
+		if !ok {
+			return false
+		}
+		v = p
+	}
+}
+
+func (t *traversal[T, D]) discover(v T, depth int) bool {
+	t.r.Order = append(t.r.Order, v)
+	t.r.Depth[v] = depth
+	t.r.Discover[v] = t.time
+	t.time++
+	return t.vertexHook(t.vis.DiscoverVertex, v)
+}
+
+func (t *traversal[T, D]) finish(v T) bool {
+	t.r.Finish[v] = t.time
+	t.time++
+	return t.vertexHook(t.vis.FinishVertex, v)
+}
+
+func (t *traversal[T, D]) vertexHook(hook func(T) bool, v T) bool {
+	if hook == nil || hook(v) {
+		return true
+	}
+	t.r.Stopped = true
+	return false
+}
+
+func (t *traversal[T, D]) edgeHook(hook func(T, Edge[T, D]) bool, from T, e Edge[T, D]) bool {
+	if hook == nil || hook(from, e) {
+		return true
+	}
+	t.r.Stopped = true
+	return false
+}
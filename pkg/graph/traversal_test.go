@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// path 0-1-2-3 with a chord 0-2 and a separate vertex 4
+func traversalTestGraph() Graph[int, string] {
+	g := NewAdjacencyListGraph[int, string]()
+	for i := 0; i <= 4; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+	g.AddEdge(2, 3, "2:3")
+	g.AddEdge(0, 2, "0:2")
+	return g
+}
+
+func TestBFS(t *testing.T) {
+	g := traversalTestGraph()
+	var tree, cross int
+	vis := &Visitor[int, string]{
+		TreeEdge:  func(int, Edge[int, string]) bool { tree++; return true },
+		CrossEdge: func(int, Edge[int, string]) bool { cross++; return true },
+	}
+	r, err := BFS(g, 0, vis, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expectedDepth := map[int]int{0: 0, 1: 1, 2: 1, 3: 2}
+	if !reflect.DeepEqual(r.Depth, expectedDepth) {
+		t.Fatalf("expected %v got %v", expectedDepth, r.Depth)
+	}
+	if r.Order[0] != 0 || r.Order[3] != 3 {
+		t.Fatalf("unexpected order %v", r.Order)
+	}
+	if r.Parent[3] != 2 {
+		t.Fatalf("expected parent of 3 to be 2 got %d", r.Parent[3])
+	}
+	if tree != 3 || cross != 1 {
+		t.Fatalf("expected 3 tree and 1 cross edge got %d and %d", tree, cross)
+	}
+	for v := range r.Depth {
+		if r.Discover[v] >= r.Finish[v] {
+			t.Fatalf("expected %d discovered before finished", v)
+		}
+	}
+}
+
+func TestDFSUndirected(t *testing.T) {
+	g := traversalTestGraph()
+	var tree, back int
+	vis := &Visitor[int, string]{
+		TreeEdge: func(int, Edge[int, string]) bool { tree++; return true },
+		BackEdge: func(int, Edge[int, string]) bool { back++; return true },
+	}
+	r, err := DFS(g, 0, vis, nil)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(r.Order) != 4 {
+		t.Fatalf("expected 4 vertices got %v", r.Order)
+	}
+	if tree != 3 || back != 1 {
+		t.Fatalf("expected 3 tree and 1 back edge got %d and %d", tree, back)
+	}
+
+	// descendants' intervals nest inside their ancestors'
+	for v, p := range r.Parent {
+		if r.Discover[p] > r.Discover[v] || r.Finish[v] > r.Finish[p] {
+			t.Fatalf("interval of %d does not nest in %d", v, p)
+		}
+	}
+}
+
+func TestDFSDirectedClassification(t *testing.T) {
+	g := NewDirectedAdjacencyListGraph[int, string]()
+	for i := 0; i <= 3; i++ {
+		g.AddVertex(i)
+	}
+	g.AddEdge(0, 1, "0:1")
+	g.AddEdge(1, 2, "1:2")
+	g.AddEdge(2, 0, "2:0")
+
+	edges := make(map[string][]Edge[int, string])
+	record := func(kind string) func(int, Edge[int, string]) bool {
+		return func(_ int, e Edge[int, string]) bool {
+			edges[kind] = append(edges[kind], e)
+			return true
+		}
+	}
+	vis := &Visitor[int, string]{
+		TreeEdge:    record("tree"),
+		BackEdge:    record("back"),
+		ForwardEdge: record("forward"),
+		CrossEdge:   record("cross"),
+	}
+
+	DFS[int, string](g, 0, vis, nil)
+	if len(edges["tree"]) != 2 || len(edges["back"]) != 1 || edges["back"][0] != NewEdge(2, 0, "2:0") {
+		t.Fatalf("unexpected classification %v", edges)
+	}
+
+	g.AddEdge(0, 2, "0:2")
+	g.AddEdge(3, 1, "3:1")
+	clear(edges)
+	r := DFSForest[int, string](g, vis, nil)
+	if len(r.Order) != 4 {
+		t.Fatalf("expected forest to cover 4 vertices got %v", r.Order)
+	}
+	total := 0
+	for _, e := range edges {
+		total += len(e)
+	}
+	if total != 5 {
+		t.Fatalf("expected every edge classified once got %v", edges)
+	}
+	roots := len(r.Order) - len(r.Parent)
+	if len(edges["tree"]) != len(r.Order)-roots || len(edges["back"]) == 0 {
+		t.Fatalf("unexpected classification %v", edges)
+	}
+}
+
+func TestTraversalStop(t *testing.T) {
+	g := traversalTestGraph()
+	vis := &Visitor[int, string]{
+		DiscoverVertex: func(v int) bool { return v != 3 },
+	}
+	r, _ := BFS(g, 0, vis, nil)
+	if !r.Stopped || r.Order[len(r.Order)-1] != 3 {
+		t.Fatalf("expected BFS to stop at 3 got %v", r.Order)
+	}
+
+	r, _ = DFS(g, 0, vis, nil)
+	if !r.Stopped {
+		t.Fatal("expected DFS to stop")
+	}
+	if _, ok := r.Finish[0]; ok {
+		t.Fatal("expected DFS to stop before finishing the root")
+	}
+}
+
+func TestTraversalMaxDepth(t *testing.T) {
+	g := traversalTestGraph()
+	r, _ := BFS(g, 0, nil, &TraversalOptions{MaxDepth: 1})
+	if len(r.Order) != 3 {
+		t.Fatalf("expected 0, 1 and 2 got %v", r.Order)
+	}
+
+	r, _ = DFS(g, 3, nil, &TraversalOptions{MaxDepth: 1})
+	if len(r.Order) != 2 || r.Order[1] != 2 {
+		t.Fatalf("expected 3 and 2 got %v", r.Order)
+	}
+}
+
+func TestTraversalUnknownSource(t *testing.T) {
+	g := traversalTestGraph()
+	if _, err := BFS(g, 9, nil, nil); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+	if _, err := DFS(g, 9, nil, nil); !errors.Is(err, ErrVertexNotFound) {
+		t.Fatalf("expected ErrVertexNotFound got %v", err)
+	}
+}
@@ -1,6 +1,10 @@
 package graph
 
-import "container/list"
+import (
+	"container/heap"
+	"container/list"
+	"sort"
+)
 
 func mapKeys[T comparable, V any](m map[T]V) []T {
 	r := make([]T, 0, len(m))
@@ -18,3 +22,91 @@ func elementFromList[T comparable](l list.List, v T) *list.Element {
     }
 	return nil
 }
+
+// incidentEdges returns every edge touching v, for a directed graph that is
+// both its out and in edges so the graph is treated as undirected
+func incidentEdges[T comparable, D comparable](g Graph[T, D], v T) []Edge[T, D] {
+	if dg, ok := g.(DirectedGraph[T, D]); ok {
+		return append(dg.OutEdges(v), dg.InEdges(v)...)
+	}
+	return g.VectorEdges(v)
+}
+
+// priorityQueue is a container/heap implementation ordered by less
+type priorityQueue[E any] struct {
+	items []E
+	less  func(a, b E) bool
+}
+
+func newPriorityQueue[E any](less func(a, b E) bool) *priorityQueue[E] {
+	return &priorityQueue[E]{items: make([]E, 0), less: less}
+}
+
+func (q *priorityQueue[E]) Len() int {
+	return len(q.items)
+}
+
+func (q *priorityQueue[E]) Less(i, j int) bool {
+	return q.less(q.items[i], q.items[j])
+}
+
+func (q *priorityQueue[E]) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+}
+
+func (q *priorityQueue[E]) Push(x any) {
+	q.items = append(q.items, x.(E))
+}
+
+func (q *priorityQueue[E]) Pop() any {
+	n := len(q.items) - 1
+	item := q.items[n]
+	q.items = q.items[:n]
+	return item
+}
+
+func (q *priorityQueue[E]) push(item E) {
+	heap.Push(q, item)
+}
+
+func (q *priorityQueue[E]) pop() E {
+	return heap.Pop(q).(E)
+}
+
+// sortedByID returns the vertices of g ordered by their ID, along with the ID
+// of each, so the writers always give the same output for the same graph
+func sortedByID[T comparable, D comparable](g Graph[T, D], id func(T) string) ([]T, map[T]string) {
+	vertices := g.Vertices()
+	ids := make(map[T]string, len(vertices))
+	for _, v := range vertices {
+		ids[v] = id(v)
+	}
+	sort.Slice(vertices, func(i, j int) bool { return ids[vertices[i]] < ids[vertices[j]] })
+	return vertices, ids
+}
+
+// idEdge is an edge with the IDs of the endpoints it is written with
+type idEdge[T comparable, D comparable] struct {
+	u, v string
+	e    Edge[T, D]
+}
+
+// edgesByID orders the edges of g by the IDs of their endpoints, undirected
+// edges are given with the lower ID first
+func edgesByID[T comparable, D comparable](g Graph[T, D], ids map[T]string, directed bool) []idEdge[T, D] {
+	edges := make([]idEdge[T, D], 0)
+	for _, e := range g.Edges() {
+		u, v := ids[e.u], ids[e.v]
+		if !directed && v < u {
+			u, v = v, u
+		}
+		edges = append(edges, idEdge[T, D]{u, v, e})
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].u != edges[j].u {
+			return edges[i].u < edges[j].u
+		}
+		return edges[i].v < edges[j].v
+	})
+	return edges
+}
@@ -0,0 +1,326 @@
+package graph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// AttributeType is the declared type of a GraphML or GEXF attribute, it fixes
+// the Go type attribute values are given as
+type AttributeType int
+
+const (
+	// StringAttribute values are string
+	StringAttribute AttributeType = iota
+	// BoolAttribute values are bool
+	BoolAttribute
+	// IntAttribute values are int
+	IntAttribute
+	// LongAttribute values are int64
+	LongAttribute
+	// FloatAttribute values are float32
+	FloatAttribute
+	// DoubleAttribute values are float64
+	DoubleAttribute
+)
+
+// XMLKey declares an attribute, Default is used when a vertex or edge has no
+// value for it and may be nil
+type XMLKey struct {
+	Name    string
+	Type    AttributeType
+	Default any
+}
+
+// XMLCodec converts a graph to and from the attributes stored by the GraphML
+// and GEXF readers and writers. Writing uses VertexID, which defaults to the
+// fmt representation and must be unique, and the attribute functions, whose
+// values must have the Go type of their key; names not declared in the keys
+// are skipped. Reading needs Vertex and Edge, which are given the attributes
+// in the file, converted according to the keys it declares.
+type XMLCodec[T comparable, D comparable] struct {
+	VertexKeys       []XMLKey
+	EdgeKeys         []XMLKey
+	VertexID         func(v T) string
+	VertexAttributes func(v T) map[string]any
+	EdgeAttributes   func(e Edge[T, D]) map[string]any
+	Vertex           func(id string, attrs map[string]any) (T, error)
+	Edge             func(attrs map[string]any) (D, error)
+}
+
+func (c *XMLCodec[T, D]) vertexID() func(T) string {
+	if c.VertexID != nil {
+		return c.VertexID
+	}
+	return func(v T) string { return fmt.Sprint(v) }
+}
+
+// xmlValues formats the value of every key in attrs, calling write with the
+// index of the key and the text to store
+func xmlValues(keys []XMLKey, attrs map[string]any, write func(i int, s string)) error {
+	for i, k := range keys {
+		v, ok := attrs[k.Name]
+		if !ok {
+			continue
+		}
+		s, err := formatAttribute(k, v)
+		if err != nil {
+			return err
+		}
+		write(i, s)
+	}
+	return nil
+}
+
+// formatAttribute writes v so parseAttribute gives back the same value
+func formatAttribute(k XMLKey, v any) (string, error) {
+	switch k.Type {
+	case StringAttribute:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case BoolAttribute:
+		if b, ok := v.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+	case IntAttribute:
+		if n, ok := v.(int); ok {
+			return strconv.Itoa(n), nil
+		}
+	case LongAttribute:
+		if n, ok := v.(int64); ok {
+			return strconv.FormatInt(n, 10), nil
+		}
+	case FloatAttribute:
+		if f, ok := v.(float32); ok {
+			return strconv.FormatFloat(float64(f), 'g', -1, 32), nil
+		}
+	case DoubleAttribute:
+		if f, ok := v.(float64); ok {
+			return strconv.FormatFloat(f, 'g', -1, 64), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q has value %v of type %T", ErrAttributeType, k.Name, v, v)
+}
+
+func parseAttribute(k XMLKey, s string) (any, error) {
+	var v any
+	var err error
+	switch k.Type {
+	case StringAttribute:
+		v = s
+	case BoolAttribute:
+		v, err = strconv.ParseBool(s)
+	case IntAttribute:
+		v, err = strconv.Atoi(s)
+	case LongAttribute:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case FloatAttribute:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		v = float32(f)
+	case DoubleAttribute:
+		v, err = strconv.ParseFloat(s, 64)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: attribute %q: %v", ErrSyntax, k.Name, err)
+	}
+	return v, nil
+}
+
+// xmlWriter keeps the first error from the encoder so writing can carry on
+// unchecked and report it once at the end
+type xmlWriter struct {
+	enc *xml.Encoder
+	err error
+}
+
+func newXMLWriter(w io.Writer) *xmlWriter {
+	x := &xmlWriter{enc: xml.NewEncoder(w)}
+	x.enc.Indent("", "  ")
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		x.err = err
+	}
+	return x
+}
+
+// start opens an element, attrs are name value pairs
+func (x *xmlWriter) start(name string, attrs ...string) {
+	el := xml.StartElement{Name: xml.Name{Local: name}}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		el.Attr = append(el.Attr, xml.Attr{Name: xml.Name{Local: attrs[i]}, Value: attrs[i+1]})
+	}
+	x.token(el)
+}
+
+func (x *xmlWriter) end(name string) {
+	x.token(xml.EndElement{Name: xml.Name{Local: name}})
+}
+
+// element writes a whole element holding only text
+func (x *xmlWriter) element(name string, text string, attrs ...string) {
+	x.start(name, attrs...)
+	x.token(xml.CharData(text))
+	x.end(name)
+}
+
+func (x *xmlWriter) token(t xml.Token) {
+	if x.err == nil {
+		x.err = x.enc.EncodeToken(t)
+	}
+}
+
+func (x *xmlWriter) close() error {
+	if x.err == nil {
+		x.err = x.enc.Flush()
+	}
+	return x.err
+}
+
+// xmlAttr returns the value of the named attribute of el
+func xmlAttr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// xmlText reads the text content of el
+func xmlText(dec *xml.Decoder, el xml.StartElement) (string, error) {
+	var s struct {
+		Text string `xml:",chardata"`
+	}
+	if err := dec.DecodeElement(&s, &el); err != nil {
+		return "", err
+	}
+	return s.Text, nil
+}
+
+// xmlBuilder rebuilds a graph while reading, edges naming a vertex not yet
+// seen are held back until the end of the graph
+type xmlBuilder[T comparable, D comparable] struct {
+	codec   *XMLCodec[T, D]
+	g       Graph[T, D]
+	ids     map[string]T
+	pending []xmlPendingEdge[D]
+}
+
+type xmlPendingEdge[D comparable] struct {
+	u, v string
+	d    D
+}
+
+func newXMLBuilder[T comparable, D comparable](codec *XMLCodec[T, D], directed bool) (*xmlBuilder[T, D], error) {
+	if codec == nil || codec.Vertex == nil || codec.Edge == nil {
+		return nil, fmt.Errorf("graph: codec must convert vertices and edges")
+	}
+	b := &xmlBuilder[T, D]{codec: codec, ids: make(map[string]T)}
+	if directed {
+		b.g = NewDirectedAdjacencyListGraph[T, D]()
+	} else {
+		b.g = NewAdjacencyListGraph[T, D]()
+	}
+	return b, nil
+}
+
+func (b *xmlBuilder[T, D]) vertex(id string, attrs map[string]any, keys []XMLKey) error {
+	if _, ok := b.ids[id]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateVertex, id)
+	}
+	v, err := b.codec.Vertex(id, withDefaults(attrs, keys))
+	if err != nil {
+		return err
+	}
+	if err := b.g.AddVertex(v); err != nil {
+		return err
+	}
+	b.ids[id] = v
+	return nil
+}
+
+func (b *xmlBuilder[T, D]) edge(u string, v string, attrs map[string]any, keys []XMLKey) error {
+	d, err := b.codec.Edge(withDefaults(attrs, keys))
+	if err != nil {
+		return err
+	}
+	tu, okU := b.ids[u]
+	tv, okV := b.ids[v]
+	if !okU || !okV {
+		b.pending = append(b.pending, xmlPendingEdge[D]{u, v, d})
+		return nil
+	}
+	_, err = b.g.AddEdge(tu, tv, d)
+	return err
+}
+
+// finish adds the edges that were held back, every vertex has been seen now
+func (b *xmlBuilder[T, D]) finish() error {
+	for _, p := range b.pending {
+		u, ok := b.ids[p.u]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrVertexNotFound, p.u)
+		}
+		v, ok := b.ids[p.v]
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrVertexNotFound, p.v)
+		}
+		if _, err := b.g.AddEdge(u, v, p.d); err != nil {
+			return err
+		}
+	}
+	b.pending = nil
+	return nil
+}
+
+func withDefaults(attrs map[string]any, keys []XMLKey) map[string]any {
+	for _, k := range keys {
+		if _, ok := attrs[k.Name]; !ok && k.Default != nil {
+			attrs[k.Name] = k.Default
+		}
+	}
+	return attrs
+}
+
+// attributeType looks up the type declared by name, types the package does
+// not know are read as strings
+func attributeType(names map[AttributeType]string, name string) AttributeType {
+	for t, n := range names {
+		if n == name {
+			return t
+		}
+	}
+	return StringAttribute
+}
+
+// readXMLKeyDefault reads the rest of a key declaration, setting the default
+// from its default element if it has one
+func readXMLKeyDefault(dec *xml.Decoder, k *XMLKey) error {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "default" {
+				if err := dec.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			text, err := xmlText(dec, t)
+			if err != nil {
+				return err
+			}
+			if k.Default, err = parseAttribute(*k, text); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
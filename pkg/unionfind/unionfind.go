@@ -0,0 +1,129 @@
+package unionfind
+
+import "mervynrussell/gocol/pkg/set"
+
+type UnionFind[T comparable] interface {
+	Add(item T) bool
+	Find(item T) (T, bool)
+	Union(a T, b T) bool
+	Connected(a T, b T) bool
+	Contains(item T) bool
+	Len() int
+	Count() int
+	Sets() []set.Set[T]
+}
+
+// UnionFind implementation based on a forest of parent pointers with path
+// compression and union by rank
+type forest[T comparable] struct {
+	parent map[T]T
+	rank   map[T]int
+	count  int
+}
+
+// New empty UnionFind based on forest
+func New[T comparable]() UnionFind[T] {
+	return &forest[T]{parent: make(map[T]T), rank: make(map[T]int)}
+}
+
+// NewFrom UnionFind with each item in a set of its own
+func NewFrom[T comparable](items []T) UnionFind[T] {
+	f := New[T]()
+	for _, item := range items {
+		f.Add(item)
+	}
+	return f
+}
+
+// Add item as a set of its own, false if it is already present
+func (f *forest[T]) Add(item T) bool {
+	if f.Contains(item) {
+		return false
+	}
+	f.parent[item] = item
+	f.rank[item] = 0
+	f.count++
+	return true
+}
+
+// Find the representative of the set containing item
+func (f *forest[T]) Find(item T) (T, bool) {
+	if !f.Contains(item) {
+		return item, false
+	}
+	root := item
+	for f.parent[root] != root {
+		root = f.parent[root]
+	}
+	for item != root {
+		next := f.parent[item]
+		f.parent[item] = root
+		item = next
+	}
+	return root, true
+}
+
+// Union merges the sets containing a and b, adding either if missing. False
+// if they were already in the same set.
+func (f *forest[T]) Union(a T, b T) bool {
+	f.Add(a)
+	f.Add(b)
+	ra, _ := f.Find(a)
+	rb, _ := f.Find(b)
+	if ra == rb {
+		return false
+	}
+	switch {
+	case f.rank[ra] < f.rank[rb]:
+		f.parent[ra] = rb
+	case f.rank[ra] > f.rank[rb]:
+		f.parent[rb] = ra
+	default:
+		f.parent[rb] = ra
+		f.rank[ra]++
+	}
+	f.count--
+	return true
+}
+
+// Connected reports whether a and b are in the same set
+func (f *forest[T]) Connected(a T, b T) bool {
+	ra, ok := f.Find(a)
+	if !ok {
+		return false
+	}
+	rb, ok := f.Find(b)
+	return ok && ra == rb
+}
+
+func (f *forest[T]) Contains(item T) bool {
+	_, ok := f.parent[item]
+	return ok
+}
+
+// Len return number of items
+func (f *forest[T]) Len() int {
+	return len(f.parent)
+}
+
+// Count return number of disjoint sets
+func (f *forest[T]) Count() int {
+	return f.count
+}
+
+// Sets returns every disjoint set
+func (f *forest[T]) Sets() []set.Set[T] {
+	byRoot := make(map[T]set.Set[T])
+	r := make([]set.Set[T], 0, f.count)
+	for item := range f.parent {
+		root, _ := f.Find(item)
+		s, ok := byRoot[root]
+		if !ok {
+			s = set.New[T]()
+			byRoot[root] = s
+			r = append(r, s)
+		}
+		s.Add(item)
+	}
+	return r
+}
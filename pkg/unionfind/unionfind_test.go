@@ -0,0 +1,84 @@
+package unionfind
+
+import (
+	"mervynrussell/gocol/pkg/set"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	uf := NewFrom([]int{1, 2, 3, 4, 5})
+	if uf.Len() != 5 || uf.Count() != 5 {
+		t.Fatalf("expected 5 sets of 5 items got %d of %d", uf.Count(), uf.Len())
+	}
+
+	if !uf.Union(1, 2) || !uf.Union(3, 4) || !uf.Union(2, 4) {
+		t.Fatal("expected union of disjoint sets to be true")
+	}
+	if uf.Union(1, 3) {
+		t.Fatal("expected union of same set to be false")
+	}
+	if uf.Count() != 2 {
+		t.Fatalf("expected 2 got %d", uf.Count())
+	}
+
+	if !uf.Connected(1, 4) || uf.Connected(1, 5) {
+		t.Fatal("unexpected connectivity")
+	}
+
+	r1, _ := uf.Find(1)
+	r3, _ := uf.Find(3)
+	if r1 != r3 {
+		t.Fatalf("expected same representative got %d and %d", r1, r3)
+	}
+}
+
+func TestAddMissing(t *testing.T) {
+	uf := New[string]()
+	if _, ok := uf.Find("a"); ok {
+		t.Fatal("expected not ok finding missing item")
+	}
+	if uf.Connected("a", "a") {
+		t.Fatal("expected missing item not connected")
+	}
+
+	uf.Union("a", "b")
+	if !uf.Contains("a") || !uf.Contains("b") || uf.Count() != 1 {
+		t.Fatal("expected union to add missing items")
+	}
+	if uf.Add("a") {
+		t.Fatal("expected add of existing item to be false")
+	}
+}
+
+func TestSets(t *testing.T) {
+	uf := NewFrom([]int{1, 2, 3, 4, 5, 6})
+	uf.Union(1, 2)
+	uf.Union(2, 3)
+	uf.Union(5, 6)
+
+	sets := uf.Sets()
+	if len(sets) != 3 {
+		t.Fatalf("expected 3 got %d", len(sets))
+	}
+	expected := []set.Set[int]{set.NewFrom([]int{1, 2, 3}), set.NewFrom([]int{4}), set.NewFrom([]int{5, 6})}
+	for _, e := range expected {
+		found := false
+		for _, s := range sets {
+			found = found || s.Equals(e)
+		}
+		if !found {
+			t.Fatalf("expected set %v", e.All())
+		}
+	}
+}
+
+func TestLargeChain(t *testing.T) {
+	uf := New[int]()
+	n := 100000
+	for i := 1; i < n; i++ {
+		uf.Union(i-1, i)
+	}
+	if uf.Count() != 1 || !uf.Connected(0, n-1) {
+		t.Fatal("expected a single set")
+	}
+}